@@ -0,0 +1,240 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/iancoleman/strcase"
+	terraformpb "github.com/protomesh/protoc-gen-terraform/proto/terraform"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// attributeDescription is the part of an attribute schema the existing states and configurations
+// depend on, the schema functions are written from it and the breaking mode compares it
+type attributeDescription struct {
+	typ        string
+	elem       string
+	required   bool
+	optional   bool
+	computed   bool
+	forceNew   bool
+	minItems   uint32
+	maxItems   uint32
+	hashKeys   []string
+	enumValues []string
+
+	// Attributes of the nested block, only described by the breaking mode: the
+	// schema functions reference the schema function of the block message
+	block map[string]*attributeDescription
+}
+
+// describeAttribute returns the description of the field attribute, recursive fields
+// past the maximum depth are described by the caller
+func (fdInfo *fieldInfo) describeAttribute() *attributeDescription {
+
+	attr := &attributeDescription{
+		forceNew: fdInfo.schema.ForceNew,
+		minItems: fdInfo.schema.MinItems,
+		maxItems: fdInfo.schema.MaxItems,
+		hashKeys: fdInfo.schema.HashKeys,
+	}
+
+	switch {
+
+	case fdInfo.schema.DefaultValue != nil:
+		attr.optional = true
+
+	case fdInfo.schema.Required:
+		attr.required = true
+
+	case fdInfo.schema.Computed:
+		attr.computed = true
+
+	default:
+		attr.optional = true
+
+	}
+
+	if attr.minItems > 0 || attr.maxItems > 0 {
+
+		if !fdInfo.value.Desc.IsList() && !fdInfo.isMessageMap() {
+			panic(fmt.Sprintf("min_items and max_items of %s are only supported on repeated fields and maps of messages", fdInfo.value.Desc.FullName()))
+		}
+
+		if attr.maxItems > 0 && attr.minItems > attr.maxItems {
+			panic(fmt.Sprintf("min_items of %s is greater than max_items", fdInfo.value.Desc.FullName()))
+		}
+
+	}
+
+	block := fdInfo.value.Message != nil && !isWellKnownMessage(fdInfo.value.Message)
+
+	switch {
+
+	case fdInfo.isMessageMap():
+		attr.typ = "set"
+
+	case fdInfo.value.Desc.IsMap():
+		attr.typ = "map"
+		attr.elem, attr.enumValues = fdInfo.mapValueInfo().describeValue()
+
+	case fdInfo.value.Desc.IsList():
+
+		attr.typ = "list"
+
+		if fdInfo.schema.IsTypeSet {
+			attr.typ = "set"
+		}
+
+		if !block {
+			attr.elem, attr.enumValues = fdInfo.describeValue()
+		}
+
+	case block:
+
+		// Single blocks are lists of at most one block
+		attr.typ = "list"
+		attr.maxItems = 1
+
+	default:
+		attr.typ, attr.enumValues = fdInfo.describeValue()
+
+	}
+
+	// Required blocks must have at least one
+	if fieldMsg := fieldMessage(fdInfo.value); attr.minItems == 0 && attr.required && fieldMsg != nil && !isWellKnownMessage(fieldMsg) {
+		attr.minItems = 1
+	}
+
+	return attr
+
+}
+
+// describeValue returns the terraform type of a single value and the accepted enum values
+func (fdInfo *fieldInfo) describeValue() (string, []string) {
+
+	switch fdInfo.value.Desc.Kind() {
+
+	case protoreflect.BoolKind:
+		return "bool", nil
+
+	case protoreflect.EnumKind:
+
+		eInfo := newEnumInfo(fdInfo.value.Enum)
+
+		values := []string{}
+
+		for _, value := range eInfo.terraformValues() {
+
+			if eInfo.schema.AsInt {
+				values = append(values, fmt.Sprintf("%d", value.Desc.Number()))
+			} else {
+				values = append(values, eInfo.terraformName(value))
+			}
+
+		}
+
+		if eInfo.schema.AsInt {
+			return "int", values
+		}
+
+		return "string", values
+
+	case protoreflect.DoubleKind, protoreflect.FloatKind:
+		return "float", nil
+
+	// Durations are the only messages described as values
+	case protoreflect.StringKind, protoreflect.BytesKind, protoreflect.MessageKind:
+		return "string", nil
+
+	}
+
+	if fdInfo.isInt64AsString() {
+		return "string", nil
+	}
+
+	return "int", nil
+
+}
+
+// describeAttribute returns the description of the block of the oneof, flattened
+// oneofs are described by their fields
+func (oInfo *oneOfInfo) describeAttribute() *attributeDescription {
+
+	return &attributeDescription{
+		typ:      "list",
+		required: oInfo.schema.Required,
+		optional: !oInfo.schema.Required,
+		maxItems: 1,
+	}
+
+}
+
+// describeVirtualAttribute returns the description of an attribute without field
+func describeVirtualAttribute(attr *terraformpb.VirtualAttribute) *attributeDescription {
+
+	desc := &attributeDescription{
+		typ:      "string",
+		required: attr.Required,
+		computed: !attr.Required && attr.Computed,
+		optional: !attr.Required && !attr.Computed,
+	}
+
+	switch attr.Type {
+
+	case terraformpb.AttributeType_ATTRIBUTE_TYPE_INT:
+		desc.typ = "int"
+
+	case terraformpb.AttributeType_ATTRIBUTE_TYPE_FLOAT:
+		desc.typ = "float"
+
+	case terraformpb.AttributeType_ATTRIBUTE_TYPE_BOOL:
+		desc.typ = "bool"
+
+	}
+
+	return desc
+
+}
+
+// schemaType returns the schema.ValueType of a described type
+func schemaType(typ string) string {
+	return "schema.Type" + strcase.ToCamel(typ)
+}
+
+// writeSchemaType writes the type of the attribute and the bounds of its number of elements
+func (attr *attributeDescription) writeSchemaType(t tab, gen *protogen.GeneratedFile) {
+
+	t.P(gen, `Type: `, schemaType(attr.typ), `,`)
+
+	if attr.minItems > 0 {
+		t.P(gen, `MinItems: `, attr.minItems, `,`)
+	}
+
+	if attr.maxItems > 0 {
+		t.P(gen, `MaxItems: `, attr.maxItems, `,`)
+	}
+
+}
+
+// writeSchemaBehavior writes how the attribute is set and changed
+func (attr *attributeDescription) writeSchemaBehavior(t tab, gen *protogen.GeneratedFile) {
+
+	switch {
+
+	case attr.required:
+		t.P(gen, `Required: true,`)
+
+	case attr.computed:
+		t.P(gen, `Computed: true,`)
+
+	default:
+		t.P(gen, `Optional: true,`)
+
+	}
+
+	if attr.forceNew {
+		t.P(gen, `ForceNew: true,`)
+	}
+
+}
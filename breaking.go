@@ -0,0 +1,351 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+const breakingUsage = "usage: protoc-gen-terraform breaking <previous descriptor set> <current descriptor set>"
+
+// runBreaking compares the terraform schemas generated from two descriptor sets (built with
+// protoc --include_imports --descriptor_set_out) and reports the changes breaking the existing
+// states and configurations to stdout, it returns the exit code of the command
+func runBreaking(args []string, stdout, stderr io.Writer) (code int) {
+
+	// Invalid schemas are panics, as when generating them
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Fprintln(stderr, r)
+			code = 2
+		}
+	}()
+
+	if len(args) != 2 {
+		fmt.Fprintln(stderr, breakingUsage)
+		return 2
+	}
+
+	previous, err := loadTerraformSchemas(args[0])
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	current, err := loadTerraformSchemas(args[1])
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	changes := []string{}
+
+	for name, prevSchema := range previous {
+
+		curSchema, ok := current[name]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("%s: schema removed", name))
+			continue
+		}
+
+		changes = append(changes, compareTerraformBlocks(name, prevSchema, curSchema)...)
+
+	}
+
+	sort.Strings(changes)
+
+	for _, change := range changes {
+		fmt.Fprintln(stdout, change)
+	}
+
+	if len(changes) > 0 {
+		return 1
+	}
+
+	return 0
+
+}
+
+// loadTerraformSchemas returns the schemas of the generated messages of the descriptor set by message name
+func loadTerraformSchemas(filename string) (map[string]map[string]*attributeDescription, error) {
+
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	set := &descriptorpb.FileDescriptorSet{}
+
+	if err := proto.Unmarshal(b, set); err != nil {
+		return nil, fmt.Errorf("invalid descriptor set %s: %w", filename, err)
+	}
+
+	req := &pluginpb.CodeGeneratorRequest{
+		ProtoFile: set.File,
+	}
+
+	// Go packages do not matter to the schemas, files without one get a placeholder
+	params := []string{}
+
+	for _, file := range set.File {
+
+		req.FileToGenerate = append(req.FileToGenerate, file.GetName())
+
+		if len(file.GetOptions().GetGoPackage()) == 0 {
+			params = append(params, fmt.Sprintf("M%s=%s", file.GetName(), path.Dir(file.GetName())))
+		}
+
+	}
+
+	if len(params) > 0 {
+		req.Parameter = proto.String(strings.Join(params, ","))
+	}
+
+	plugin, err := protogen.Options{}.New(req)
+	if err != nil {
+		return nil, fmt.Errorf("invalid descriptor set %s: %w", filename, err)
+	}
+
+	schemas := map[string]map[string]*attributeDescription{}
+
+	for _, file := range plugin.Files {
+
+		for _, msg := range file.Messages {
+
+			if msgSchema := getMessageSchema(msg.Desc); msgSchema.Generate {
+				schemas[string(msg.Desc.FullName())] = describeMessage(msg, int(msgSchema.MaxDepth))
+			}
+
+		}
+
+	}
+
+	return schemas, nil
+
+}
+
+// describeMessage mirrors the schema function of the message, recursive fields are
+// unrolled while there is depth left
+func describeMessage(msg *protogen.Message, depth int) map[string]*attributeDescription {
+
+	mInfo := newMessageInfo(nil, msg)
+
+	attrs := map[string]*attributeDescription{}
+
+	for _, field := range msg.Fields {
+
+		fdInfo := newFieldInfo(nil, field)
+
+		if fdInfo.schema.Ignore || (isOneofMember(field) && !newOneOfInfo(nil, field.Oneof).schema.Flatten) {
+			continue
+		}
+
+		attrs[fdInfo.fieldKey] = describeField(fdInfo, depth)
+
+	}
+
+	for _, oneOf := range realOneofs(msg) {
+
+		oInfo := newOneOfInfo(nil, oneOf)
+
+		if oInfo.schema.Flatten {
+			continue
+		}
+
+		attr := oInfo.describeAttribute()
+		attr.block = map[string]*attributeDescription{}
+
+		for _, field := range oInfo.fields() {
+
+			fdInfo := newFieldInfo(nil, field)
+
+			attr.block[fdInfo.fieldKey] = describeField(fdInfo, depth)
+
+		}
+
+		attrs[oInfo.oneOfKey] = attr
+
+	}
+
+	for _, attr := range append(mInfo.schema.VirtualAttributes, mInfo.patternAttributes()...) {
+		attrs[attr.Name] = describeVirtualAttribute(attr)
+	}
+
+	return attrs
+
+}
+
+// describeField adds the attributes of the nested block to the description of the field
+func describeField(fdInfo *fieldInfo, depth int) *attributeDescription {
+
+	attr := fdInfo.describeAttribute()
+
+	// Past the maximum depth recursive fields are JSON strings
+	if fdInfo.isRecursive() && depth <= 0 {
+		return &attributeDescription{
+			typ:      "string",
+			required: attr.required,
+			optional: attr.optional,
+			computed: attr.computed,
+			forceNew: attr.forceNew,
+		}
+	}
+
+	fieldMsg := fieldMessage(fdInfo.value)
+
+	if fieldMsg == nil || isWellKnownMessage(fieldMsg) {
+		return attr
+	}
+
+	blockDepth := depth - 1
+
+	if !fdInfo.isRecursive() {
+		blockDepth = int(getMessageSchema(fieldMsg.Desc).MaxDepth)
+	}
+
+	attr.block = describeMessage(fieldMsg, blockDepth)
+
+	if fdInfo.isMessageMap() {
+		attr.block[fdInfo.mapKeyName()] = &attributeDescription{typ: "string", required: true}
+	}
+
+	return attr
+
+}
+
+// compareTerraformBlocks returns the breaking changes between two versions of a block
+func compareTerraformBlocks(blockPath string, previous, current map[string]*attributeDescription) []string {
+
+	changes := []string{}
+
+	for key, prevAttr := range previous {
+
+		attrPath := blockPath + "." + key
+
+		curAttr, ok := current[key]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("%s: attribute removed", attrPath))
+			continue
+		}
+
+		changes = append(changes, compareTerraformAttributes(attrPath, prevAttr, curAttr)...)
+
+	}
+
+	for key, curAttr := range current {
+
+		if _, ok := previous[key]; !ok && curAttr.required {
+			changes = append(changes, fmt.Sprintf("%s.%s: required attribute added", blockPath, key))
+		}
+
+	}
+
+	return changes
+
+}
+
+func compareTerraformAttributes(attrPath string, previous, current *attributeDescription) []string {
+
+	changes := []string{}
+
+	if !previous.required && current.required {
+		changes = append(changes, fmt.Sprintf("%s: optional attribute is now required", attrPath))
+	}
+
+	if !previous.forceNew && current.forceNew {
+		changes = append(changes, fmt.Sprintf("%s: changes now replace the resource", attrPath))
+	}
+
+	if previous.typ != current.typ {
+
+		if isTerraformCollection(previous.typ) && isTerraformCollection(current.typ) {
+			changes = append(changes, fmt.Sprintf("%s: %s changed to %s", attrPath, previous.typ, current.typ))
+		} else {
+			changes = append(changes, fmt.Sprintf("%s: type changed from %s to %s", attrPath, previous.typ, current.typ))
+		}
+
+		return changes
+
+	}
+
+	if (previous.block == nil) != (current.block == nil) {
+		changes = append(changes, fmt.Sprintf("%s: changed between block and attribute", attrPath))
+		return changes
+	}
+
+	if previous.elem != current.elem {
+		changes = append(changes, fmt.Sprintf("%s: element type changed from %s to %s", attrPath, previous.elem, current.elem))
+	}
+
+	// Existing configurations may have fewer or more elements, blocks becoming required
+	// need one which is reported with the required change
+	if current.minItems > previous.minItems && !(current.minItems == 1 && current.required && !previous.required) {
+		changes = append(changes, fmt.Sprintf("%s: minimum number of elements raised from %d to %d", attrPath, previous.minItems, current.minItems))
+	}
+
+	if current.maxItems > 0 && (previous.maxItems == 0 || current.maxItems < previous.maxItems) {
+		changes = append(changes, fmt.Sprintf("%s: maximum number of elements lowered from %s to %d", attrPath, describeMaxItems(previous.maxItems), current.maxItems))
+	}
+
+	// Set elements are identified by their hash, the elements in the states would be replaced
+	if previous.typ == "set" && strings.Join(previous.hashKeys, ",") != strings.Join(current.hashKeys, ",") {
+		changes = append(changes, fmt.Sprintf("%s: hash keys changed from %s to %s", attrPath, describeHashKeys(previous.hashKeys), describeHashKeys(current.hashKeys)))
+	}
+
+	if previous.enumValues != nil && current.enumValues != nil {
+
+		accepted := map[string]bool{}
+
+		for _, value := range current.enumValues {
+			accepted[value] = true
+		}
+
+		for _, value := range previous.enumValues {
+
+			if !accepted[value] {
+				changes = append(changes, fmt.Sprintf("%s: enum value %q removed", attrPath, value))
+			}
+
+		}
+
+	}
+
+	if previous.block != nil {
+		changes = append(changes, compareTerraformBlocks(attrPath, previous.block, current.block)...)
+	}
+
+	return changes
+
+}
+
+func isTerraformCollection(typ string) bool {
+	return typ == "list" || typ == "set"
+}
+
+func describeMaxItems(maxItems uint32) string {
+
+	if maxItems == 0 {
+		return "unbounded"
+	}
+
+	return fmt.Sprintf("%d", maxItems)
+
+}
+
+func describeHashKeys(hashKeys []string) string {
+
+	if len(hashKeys) == 0 {
+		return "the whole block"
+	}
+
+	return "[" + strings.Join(hashKeys, ", ") + "]"
+
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// writeDescriptorSet compiles the proto of testdata/protos with its imports, as protoc
+// --include_imports --descriptor_set_out does, and returns the path of the set
+func writeDescriptorSet(t *testing.T, file string) string {
+
+	t.Helper()
+
+	b, err := proto.Marshal(&descriptorpb.FileDescriptorSet{File: compileProtos(t, nil, file)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filename := filepath.Join(t.TempDir(), "descriptor.binpb")
+
+	if err := os.WriteFile(filename, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	return filename
+
+}
+
+func TestBreaking(t *testing.T) {
+
+	previous := writeDescriptorSet(t, "breaking/v1/widget.proto")
+	current := writeDescriptorSet(t, "breaking/v2/widget.proto")
+
+	invalid := filepath.Join(t.TempDir(), "invalid.binpb")
+
+	if err := os.WriteFile(invalid, []byte("not a descriptor set"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		args   []string
+		code   int
+		stdout []string
+		stderr string
+	}{
+		"breaking changes": {
+			args: []string{previous, current},
+			code: 1,
+			stdout: []string{
+				"breaking.Gizmo: schema removed",
+				"breaking.Widget.default_rule: optional attribute is now required",
+				"breaking.Widget.legacy: attribute removed",
+				"breaking.Widget.limits: maximum number of elements lowered from 5 to 3",
+				"breaking.Widget.limits: minimum number of elements raised from 0 to 1",
+				"breaking.Widget.owner: optional attribute is now required",
+				"breaking.Widget.region: required attribute added",
+				"breaking.Widget.rules: hash keys changed from [name] to [name, port]",
+				"breaking.Widget.size: type changed from int to string",
+				"breaking.Widget.tags: list changed to set",
+				`breaking.Widget.tier: enum value "TIER_PREMIUM" removed`,
+				"breaking.Widget.zone: changes now replace the resource",
+			},
+		},
+		"no changes": {
+			args: []string{previous, previous},
+			code: 0,
+		},
+		"usage": {
+			args:   []string{previous},
+			code:   2,
+			stderr: breakingUsage,
+		},
+		"invalid descriptor set": {
+			args:   []string{previous, invalid},
+			code:   2,
+			stderr: "invalid descriptor set " + invalid,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+
+			stdout := &bytes.Buffer{}
+			stderr := &bytes.Buffer{}
+
+			if code := runBreaking(test.args, stdout, stderr); code != test.code {
+				t.Errorf("exit code %d, want %d (stderr: %s)", code, test.code, stderr)
+			}
+
+			want := strings.Join(test.stdout, "\n")
+
+			if got := strings.TrimSpace(stdout.String()); got != want {
+				t.Errorf("got changes\n%s\nwant\n%s", got, want)
+			}
+
+			if !strings.Contains(stderr.String(), test.stderr) {
+				t.Errorf("stderr %q does not contain %q", stderr, test.stderr)
+			}
+
+		})
+
+	}
+
+}
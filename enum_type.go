@@ -1,8 +1,10 @@
 package main
 
 import (
+	"fmt"
 	"strings"
 
+	"github.com/iancoleman/strcase"
 	terraformpb "github.com/protomesh/protoc-gen-terraform/proto/terraform"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/proto"
@@ -48,16 +50,272 @@ func getEnumSchema(desc protoreflect.EnumDescriptor) *terraformpb.EnumSchema {
 
 }
 
-func (eInfo *enumInfo) writeSchemaValidateFunc(t tab, gen *protogen.GeneratedFile) {
+func getEnumValueSchema(desc protoreflect.EnumValueDescriptor) *terraformpb.EnumValueSchema {
 
-	possibleValues := []string{}
+	opts, ok := desc.Options().(*descriptorpb.EnumValueOptions)
+	if !ok {
+		panic("Invalid message options")
+	}
+
+	if opts != nil && proto.HasExtension(opts, terraformpb.E_EnumValueSchema) {
+
+		return proto.GetExtension(opts, terraformpb.E_EnumValueSchema).(*terraformpb.EnumValueSchema)
+
+	}
+
+	return &terraformpb.EnumValueSchema{}
+
+}
+
+// isConverted reports if the Terraform representation differs from the proto value name
+func (eInfo *enumInfo) isConverted() bool {
+
+	if eInfo.schema.AsInt || eInfo.schema.StripPrefix || eInfo.schema.Lowercase {
+		return true
+	}
+
+	for _, value := range eInfo.value.Values {
+
+		if len(getEnumValueSchema(value.Desc).Alias) > 0 {
+			return true
+		}
+
+	}
+
+	return false
+
+}
+
+func (eInfo *enumInfo) deprecationNotice(value *protogen.EnumValue) string {
+
+	if deprecation := getEnumValueSchema(value.Desc).Deprecation; len(deprecation) > 0 {
+		return deprecation
+	}
+
+	if opts, ok := value.Desc.Options().(*descriptorpb.EnumValueOptions); ok && opts.GetDeprecated() {
+		return fmt.Sprintf(defaultDeprecationMessage, "value")
+	}
+
+	return ""
+
+}
+
+func (eInfo *enumInfo) terraformValues() []*protogen.EnumValue {
+
+	values := []*protogen.EnumValue{}
 
 	for _, value := range eInfo.value.Values {
 
-		possibleValues = append(possibleValues, string(value.Desc.Name()))
+		if eInfo.schema.ExcludeUnspecified && value.Desc.Number() == 0 {
+			continue
+		}
+
+		if getEnumValueSchema(value.Desc).Hide {
+			continue
+		}
+
+		values = append(values, value)
+
+	}
+
+	return values
+
+}
+
+func (eInfo *enumInfo) terraformName(value *protogen.EnumValue) string {
+
+	if alias := getEnumValueSchema(value.Desc).Alias; len(alias) > 0 {
+		return alias
+	}
+
+	name := string(value.Desc.Name())
+
+	if eInfo.schema.StripPrefix {
+
+		// Nested enums are usually prefixed by the enum name only
+		for _, prefix := range []string{eInfo.prefix, string(eInfo.value.Desc.Name())} {
+
+			prefix = strcase.ToScreamingSnake(prefix) + "_"
+
+			if strings.HasPrefix(name, prefix) && len(name) > len(prefix) {
+				name = strings.TrimPrefix(name, prefix)
+				break
+			}
+
+		}
+
+	}
+
+	if eInfo.schema.Lowercase {
+		name = strings.ToLower(name)
+	}
+
+	return name
+
+}
+
+// checkNames reports the values sharing a terraform name, the conversion tables are keyed by them
+func (eInfo *enumInfo) checkNames() error {
+
+	if eInfo.schema.AsInt {
+		return nil
+	}
+
+	names := map[string]*protogen.EnumValue{}
+
+	for _, value := range eInfo.value.Values {
+
+		name := eInfo.terraformName(value)
+
+		if other, ok := names[name]; ok {
+			return fmt.Errorf(
+				"values %s and %s of the enum %s have the same terraform name %s, set an alias on one of them",
+				other.Desc.Name(), value.Desc.Name(), eInfo.value.Desc.FullName(), name,
+			)
+		}
+
+		names[name] = value
+
+	}
+
+	return nil
+
+}
+
+// writeToProto converts a terraform value to the value accepted by protojson, values without
+// a proto name are reported
+func (eInfo *enumInfo) writeToProto(t tab, gen *protogen.GeneratedFile, expr string, assign func(t tab, expr string)) {
+
+	if eInfo.schema.AsInt || !eInfo.isConverted() {
+		assign(t, expr)
+		return
+	}
+
+	pairs := []string{}
+
+	for _, value := range eInfo.value.Values {
+		pairs = append(pairs, fmt.Sprintf(`"%s": "%s"`, eInfo.terraformName(value), value.Desc.Name()))
+	}
+
+	t.P(gen, `name, ok := map[string]string{`, strings.Join(pairs, ", "), `}[`, expr, `]`)
+	t.P(gen, `if !ok {`)
+	t++
+	t.P(gen, `return nil, fmt.Errorf("%q is not a value of the enum `, eInfo.value.Desc.FullName(), `", `, expr, `)`)
+	t--
+	t.P(gen, `}`)
+	assign(t, `name`)
+
+}
+
+// writeFromProto converts a protojson value to the terraform value, protojson writes the
+// numbers unknown to the descriptor instead of names and they are reported
+func (eInfo *enumInfo) writeFromProto(t tab, gen *protogen.GeneratedFile, src string, assign func(t tab, expr string)) {
+
+	t.P(gen, `switch s := `, src, `.(type) {`)
+	t.P(gen, `case string:`)
+
+	t++
+
+	switch {
+
+	case !eInfo.isConverted():
+		assign(t, `s`)
+
+	case eInfo.schema.AsInt:
+
+		pairs := []string{}
+
+		for _, value := range eInfo.value.Values {
+			pairs = append(pairs, fmt.Sprintf(`"%s": %d`, value.Desc.Name(), value.Desc.Number()))
+		}
+
+		assign(t, fmt.Sprintf(`map[string]int{%s}[s]`, strings.Join(pairs, ", ")))
+
+	default:
+
+		pairs := []string{}
+
+		for _, value := range eInfo.value.Values {
+			pairs = append(pairs, fmt.Sprintf(`"%s": "%s"`, value.Desc.Name(), eInfo.terraformName(value)))
+		}
+
+		assign(t, fmt.Sprintf(`map[string]string{%s}[s]`, strings.Join(pairs, ", ")))
+
+	}
+
+	t--
+
+	t.P(gen, `case nil:`)
+	t.P(gen, `default:`)
+	t++
+	t.P(gen, `return nil, fmt.Errorf("%v is not a value of the enum `, eInfo.value.Desc.FullName(), `", s)`)
+	t--
+	t.P(gen, `}`)
+
+}
+
+// validateFunc returns the validation of the terraform values
+func (eInfo *enumInfo) validateFunc() string {
+
+	if eInfo.schema.AsInt {
+
+		possibleValues := []string{}
+
+		for _, value := range eInfo.terraformValues() {
+
+			possibleValues = append(possibleValues, fmt.Sprintf("%d", value.Desc.Number()))
+
+		}
+
+		return `validation.IntInSlice([]int{` + strings.Join(possibleValues, `,`) + `})`
+
+	}
+
+	possibleValues := []string{}
+
+	for _, value := range eInfo.terraformValues() {
+
+		possibleValues = append(possibleValues, eInfo.terraformName(value))
+
+	}
+
+	return `validation.StringInSlice([]string{"` + strings.Join(possibleValues, `","`) + `"}, false)`
+
+}
+
+// valuesDescription documents the accepted values when any of them carries comments or notices
+func (eInfo *enumInfo) valuesDescription() string {
+
+	documented := false
+
+	descriptions := []string{}
+
+	for _, value := range eInfo.terraformValues() {
+
+		description := fmt.Sprintf("`%s`", eInfo.terraformName(value))
+
+		if eInfo.schema.AsInt {
+			description = fmt.Sprintf("`%d` (%s)", value.Desc.Number(), eInfo.terraformName(value))
+		}
+
+		if comment := commentToString(value.Comments.Leading); len(comment) > 0 {
+			description = fmt.Sprintf("%s: %s", description, strings.TrimSuffix(comment, "."))
+			documented = true
+		}
+
+		if deprecation := eInfo.deprecationNotice(value); len(deprecation) > 0 {
+			description = fmt.Sprintf("%s (deprecated: %s)", description, strings.TrimSuffix(commentToString(protogen.Comments(deprecation)), "."))
+			documented = true
+		}
+
+		descriptions = append(descriptions, description)
+
+	}
 
+	if !documented {
+		return ""
 	}
 
-	t.P(gen, `ValidateFunc: validation.StringInSlice([]string{"`, strings.Join(possibleValues, `","`), `"}, false),`)
+	return fmt.Sprintf("Possible values: %s.", strings.Join(descriptions, "; "))
 
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/iancoleman/strcase"
@@ -21,9 +22,13 @@ type fieldInfo struct {
 
 	fieldName string
 	fieldKey  string
+	protoKey  string
 
 	okVar    string
 	valueVar string
+
+	conflictsWith []string
+	exactlyOneOf  []string
 }
 
 func newFieldInfo(fInfo *fileInfo, value *protogen.Field) *fieldInfo {
@@ -31,14 +36,17 @@ func newFieldInfo(fInfo *fileInfo, value *protogen.Field) *fieldInfo {
 	name := string(value.Desc.Name())
 	varName := strcase.ToCamel(name)
 
+	fdSchema := getFieldSchema(value.Desc)
+
 	return &fieldInfo{
 		fInfo: fInfo,
 
 		value:  value,
-		schema: getFieldSchema(value.Desc),
+		schema: fdSchema,
 
 		fieldName: strcase.ToCamel(name),
-		fieldKey:  string(value.Desc.Name()),
+		fieldKey:  getFieldTerraformName(value.Desc, fdSchema),
+		protoKey:  name,
 
 		okVar:    fmt.Sprintf("ok%s", varName),
 		valueVar: fmt.Sprintf("value%s", varName),
@@ -66,96 +74,354 @@ func getFieldSchema(desc protoreflect.FieldDescriptor) *terraformpb.FieldSchema
 
 }
 
-func (fdInfo *fieldInfo) writeSchema(t tab, gen *protogen.GeneratedFile) {
+func getFieldTerraformName(desc protoreflect.FieldDescriptor, fdSchema *terraformpb.FieldSchema) string {
 
-	t.P(gen, `"`, fdInfo.fieldKey, `": {`)
+	if len(fdSchema.Name) > 0 {
+		return fdSchema.Name
+	}
+
+	switch getFileSchema(desc.ParentFile()).NamingStrategy {
+
+	case terraformpb.NamingStrategy_NAMING_STRATEGY_JSON_NAME_SNAKE_CASE:
+		return toSnakeCase(desc.JSONName())
+
+	}
+
+	return string(desc.Name())
+
+}
+
+func (fdInfo *fieldInfo) deprecationMessage() string {
+
+	if len(fdInfo.schema.DeprecationMessage) > 0 {
+		return commentToString(protogen.Comments(fdInfo.schema.DeprecationMessage))
+	}
+
+	if opts, ok := fdInfo.value.Desc.Options().(*descriptorpb.FieldOptions); ok && opts.GetDeprecated() {
+		return fmt.Sprintf(defaultDeprecationMessage, "attribute")
+	}
+
+	return ""
+
+}
+
+// hasPresence reports if an unset scalar is distinguished from its zero value (proto3 optional)
+func (fdInfo *fieldInfo) hasPresence() bool {
+	return fdInfo.value.Desc.HasPresence() && !isOneofMember(fdInfo.value) && fdInfo.value.Message == nil && !fdInfo.value.Desc.IsList()
+}
+
+// presenceCondition returns the condition of a value set in the configuration, even to its zero
+// value. Without a configuration the keys of the source are the set values, when it is not known
+// only the non-zero values are. Blocks without any value have no keys, the zero value is then used
+func (fdInfo *fieldInfo) presenceCondition(configVar string) string {
+	return fmt.Sprintf(
+		`%[1]s.IsNull() && %[4]s || !%[1]s.IsNull() && %[1]s.IsKnown() && !%[1]s.GetAttr("%[2]s").IsNull() || !%[1]s.IsKnown() && !reflect.ValueOf(%[3]s).IsZero()`,
+		configVar, fdInfo.fieldKey, fdInfo.valueVar, fdInfo.okVar,
+	)
+}
+
+// usesConfig reports if unmarshaling the field reads the configuration of its block
+func (fdInfo *fieldInfo) usesConfig() bool {
+
+	msg := fieldMessage(fdInfo.value)
+
+	return !fdInfo.schema.Ignore && (fdInfo.hasPresence() || msg != nil && !isWellKnownMessage(msg))
+
+}
+
+// writeBlockConfig declares the configuration of an element of the nested block with the key, it
+// stays null or unknown with the configuration of the parent block. Set elements cannot be matched
+// with their configuration, the index is empty for them and their configuration is unknown
+func writeBlockConfig(t tab, gen *protogen.GeneratedFile, configVar, key, name, index string) {
+
+	t.P(gen, name, ` := `, configVar)
+	t.P(gen, `if `, configVar, `.IsKnown() && !`, configVar, `.IsNull() {`)
 
 	t++
 
-	if !fdInfo.writeSchemaCollectionType(t, gen) {
-		fdInfo.writeSchemaType(t, gen)
+	t.P(gen, name, ` = cty.DynamicVal`)
+
+	if len(index) > 0 {
+		t.P(gen, `if c := `, configVar, `.GetAttr("`, key, `"); c.IsKnown() && !c.IsNull() && c.LengthInt() > `, index, ` {`)
+		t++
+		t.P(gen, name, ` = c.Index(cty.NumberIntVal(int64(`, index, `)))`)
+		t--
+		t.P(gen, `}`)
 	}
-	fdInfo.writeSchemaOptions(t, gen)
-	fdInfo.writeSchemaElement(t, gen)
 
 	t--
 
-	t.P(gen, `},`)
+	t.P(gen, `}`)
 
 }
 
-func (fdInfo *fieldInfo) writeSchemaCollectionType(t tab, gen *protogen.GeneratedFile) bool {
+// isRecursive reports if the field closes a cycle back to its parent message
+func (fdInfo *fieldInfo) isRecursive() bool {
 
-	switch {
+	msg := fieldMessage(fdInfo.value)
 
-	case fdInfo.value.Desc.IsMap():
-		t.P(gen, `Type: schema.TypeMap,`)
-		return true
+	if msg == nil || isWellKnownMessage(msg) || fdInfo.value.Parent == nil {
+		return false
+	}
 
-	case fdInfo.value.Desc.IsList():
+	return messageReaches(msg, fdInfo.value.Parent)
 
-		if fdInfo.schema.IsTypeSet {
-			t.P(gen, `Type: schema.TypeSet,`)
-			return true
-		}
+}
 
-		t.P(gen, `Type: schema.TypeList,`)
-		return true
+// messageFunctionCall calls a generated function of the field message, recursive
+// messages are called with the remaining depth
+func (fdInfo *fieldInfo) messageFunctionCall(mInfo *messageInfo, functionName string, args ...string) string {
+
+	functionName = mInfo.prefixWithPackage(functionName)
+
+	if fdInfo.isRecursive() {
+		functionName = fmt.Sprintf("%sDepth", functionName)
+		args = append(args, "depth - 1")
 	}
 
-	return false
+	return fmt.Sprintf("%s(%s)", functionName, strings.Join(args, ", "))
 
 }
 
-func (fdInfo *fieldInfo) writeSchemaType(t tab, gen *protogen.GeneratedFile) {
+// isMessageMap reports if the field is a map of messages, which is a set of blocks with a key attribute
+func (fdInfo *fieldInfo) isMessageMap() bool {
 
-	msg := fdInfo.value.Desc.Message()
+	if !fdInfo.value.Desc.IsMap() {
+		return false
+	}
 
-	switch fdInfo.value.Desc.Kind() {
+	valueMsg := fdInfo.value.Message.Fields[1].Message
+
+	return valueMsg != nil && !isWellKnownMessage(valueMsg)
+
+}
+
+// mapValueInfo returns the field of the map values, named after the map in the generated errors
+func (fdInfo *fieldInfo) mapValueInfo() *fieldInfo {
+
+	valueInfo := newFieldInfo(fdInfo.fInfo, fdInfo.value.Message.Fields[1])
+	valueInfo.fieldKey = fdInfo.fieldKey
+
+	return valueInfo
+
+}
+
+func (fdInfo *fieldInfo) mapKeyName() string {
+
+	if len(fdInfo.schema.MapKey) > 0 {
+		return fdInfo.schema.MapKey
+	}
+
+	return "key"
+
+}
+
+// mapKeyPattern returns the pattern of the Terraform keys accepted by a non-string map key kind
+func (fdInfo *fieldInfo) mapKeyPattern() (string, string) {
+
+	switch fdInfo.value.Desc.MapKey().Kind() {
+
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return `^-?[0-9]+$`, "must be an integer"
+
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return `^[0-9]+$`, "must be an unsigned integer"
 
 	case protoreflect.BoolKind:
-		t.P(gen, `Type: schema.TypeBool,`)
+		return `^(true|false)$`, "must be true or false"
 
-	case protoreflect.StringKind, protoreflect.BytesKind, protoreflect.EnumKind:
-		t.P(gen, `Type: schema.TypeString,`)
+	}
 
-	case protoreflect.MessageKind:
+	return "", ""
 
-		switch msg.FullName() {
+}
 
-		case wellKnownDuration:
-			t.P(gen, `Type: schema.TypeString,`)
+// writeMapKeyConversion parses the string key into the proto key kind and returns
+// the canonical key expression accepted by protojson
+func (fdInfo *fieldInfo) writeMapKeyConversion(t tab, gen *protogen.GeneratedFile, keyVar string) string {
 
-		default:
-			t.P(gen, `Type: schema.TypeList,`)
-			t.P(gen, `MaxItems: 1,`)
+	var parse, format string
 
-		}
+	switch fdInfo.value.Desc.MapKey().Kind() {
 
-	case protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind, protoreflect.Fixed32Kind,
-		protoreflect.Fixed64Kind, protoreflect.Int32Kind, protoreflect.Int64Kind,
-		protoreflect.Sint32Kind, protoreflect.Sint64Kind, protoreflect.Uint32Kind, protoreflect.Uint64Kind:
-		t.P(gen, `Type: schema.TypeInt,`)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		parse, format = `strconv.ParseInt(`+keyVar+`, 10, 32)`, `strconv.FormatInt(key, 10)`
 
-	case
-		protoreflect.DoubleKind, protoreflect.FloatKind:
-		t.P(gen, `Type: schema.TypeFloat,`)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		parse, format = `strconv.ParseInt(`+keyVar+`, 10, 64)`, `strconv.FormatInt(key, 10)`
+
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		parse, format = `strconv.ParseUint(`+keyVar+`, 10, 32)`, `strconv.FormatUint(key, 10)`
+
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		parse, format = `strconv.ParseUint(`+keyVar+`, 10, 64)`, `strconv.FormatUint(key, 10)`
+
+	case protoreflect.BoolKind:
+		parse, format = `strconv.ParseBool(`+keyVar+`)`, `strconv.FormatBool(key)`
+
+	default:
+		return keyVar
 
 	}
 
+	t.P(gen, `key, err := `, parse)
+	t.P(gen, `if err != nil {`)
+	t++
+	t.P(gen, `return nil, fmt.Errorf("invalid key %q of `, fdInfo.fieldKey, `: %w", `, keyVar, `, err)`)
+	t--
+	t.P(gen, `}`)
+
+	return format
+
 }
 
-func (fdInfo *fieldInfo) writeSchemaElement(t tab, gen *protogen.GeneratedFile) {
+func (fdInfo *fieldInfo) checkMapKeyName() {
+
+	for _, field := range fieldMessage(fdInfo.value).Fields {
+
+		valueFdInfo := newFieldInfo(fdInfo.fInfo, field)
 
-	if isWellKnownMessage(fdInfo.value.Message) {
+		if !valueFdInfo.schema.Ignore && valueFdInfo.fieldKey == fdInfo.mapKeyName() {
+			panic(fmt.Sprintf("map key attribute %q of %s conflicts with a field of %s, set another map_key", fdInfo.mapKeyName(), fdInfo.value.Desc.FullName(), field.Parent.Desc.FullName()))
+		}
+
+	}
+
+}
+
+func (fdInfo *fieldInfo) writeSchema(t tab, gen *protogen.GeneratedFile) {
+
+	if fdInfo.schema.Ignore {
 		return
 	}
 
-	kind := fdInfo.value.Desc.Kind()
+	if fdInfo.isRecursive() {
+		fdInfo.writeRecursiveSchema(t, gen)
+		return
+	}
+
+	t.P(gen, `"`, fdInfo.fieldKey, `": {`)
+
+	t++
+
+	fdInfo.writeSchemaBody(t, gen)
+
+	t--
+
+	t.P(gen, `},`)
+
+}
+
+func (fdInfo *fieldInfo) writeSchemaBody(t tab, gen *protogen.GeneratedFile) {
+
+	attr := fdInfo.describeAttribute()
+
+	attr.writeSchemaType(t, gen)
+	fdInfo.writeSchemaOptions(t, gen, attr)
+	fdInfo.writeSchemaElement(t, gen)
+
+}
+
+// writeRecursiveSchema unrolls the nested block while there is depth left,
+// then falls back to a JSON string attribute with the proto JSON of the field
+func (fdInfo *fieldInfo) writeRecursiveSchema(t tab, gen *protogen.GeneratedFile) {
+
+	t.P(gen, `"`, fdInfo.fieldKey, `": func() *schema.Schema {`)
+
+	t++
+
+	t.P(gen, `if depth > 0 {`)
+	t++
+	t.P(gen, `return &schema.Schema{`)
+	t++
+	fdInfo.writeSchemaBody(t, gen)
+	t--
+	t.P(gen, `}`)
+	t--
+	t.P(gen, `}`)
+
+	t.P(gen, `return &schema.Schema{`)
+	t++
+	t.P(gen, `Type: schema.TypeString,`)
+	t.P(gen, `ValidateFunc: validation.StringIsJSON,`)
+	fdInfo.writeSchemaOptions(t, gen, fdInfo.describeAttribute())
+	t--
+	t.P(gen, `}`)
+
+	t--
+
+	t.P(gen, `}(),`)
+
+}
+
+// writeSchemaType writes the type of a single value, of the field or of the elements of its collection
+func (fdInfo *fieldInfo) writeSchemaType(t tab, gen *protogen.GeneratedFile) {
+
+	typ, _ := fdInfo.describeValue()
+
+	t.P(gen, `Type: `, schemaType(typ), `,`)
+
+}
+
+func (fdInfo *fieldInfo) writeSchemaElement(t tab, gen *protogen.GeneratedFile) {
+
+	block := fdInfo.value.Message != nil && !isWellKnownMessage(fdInfo.value.Message)
 
 	switch {
 
-	case kind == protoreflect.MessageKind:
+	case fdInfo.isMessageMap():
+
+		fdInfo.checkMapKeyName()
+
+		mInfo := newMessageInfo(fdInfo.fInfo, fieldMessage(fdInfo.value))
+
+		t.P(gen, `Elem: &schema.Resource{`)
+
+		t++
+
+		t.P(gen, `Schema: func() map[string]*schema.Schema {`)
+		t++
+		t.P(gen, `s := `, fdInfo.messageFunctionCall(mInfo, mInfo.schemaFunctionName))
+		t.P(gen, `s["`, fdInfo.mapKeyName(), `"] = &schema.Schema{`)
+		t++
+		t.P(gen, `Type: schema.TypeString,`)
+		t.P(gen, `Required: true,`)
+		if pattern, message := fdInfo.mapKeyPattern(); len(pattern) > 0 {
+			t.P(gen, `ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile(`, fmt.Sprintf("%q", pattern), `), "`, message, `")),`)
+		}
+		t--
+		t.P(gen, `}`)
+		t.P(gen, `return s`)
+		t--
+		t.P(gen, `}(),`)
+
+		t--
+
+		t.P(gen, `},`)
+
+	case fdInfo.value.Desc.IsMap():
+
+		valueInfo := fdInfo.mapValueInfo()
+
+		t.P(gen, `Elem: &schema.Schema{`)
+
+		t++
+
+		valueInfo.writeSchemaType(t, gen)
+
+		t--
+
+		t.P(gen, `},`)
+
+		fdInfo.writeMapValidation(t, gen)
+
+		// The map values are compared with the suppress function of the map
+		if valueInfo.isDuration() {
+			writeDurationDiffSuppress(t, gen)
+		}
+
+	case block:
 
 		t.P(gen, `Elem: &schema.Resource{`)
 
@@ -163,18 +429,24 @@ func (fdInfo *fieldInfo) writeSchemaElement(t tab, gen *protogen.GeneratedFile)
 
 		mInfo := newMessageInfo(fdInfo.fInfo, fdInfo.value.Message)
 
-		t.P(gen, `Schema: `, mInfo.prefixWithPackage(mInfo.schemaFunctionName), `(),`)
+		t.P(gen, `Schema: `, fdInfo.messageFunctionCall(mInfo, mInfo.schemaFunctionName), `,`)
 
 		t--
 
 		t.P(gen, `},`)
 
-	case fdInfo.value.Desc.IsMap(), fdInfo.value.Desc.IsList():
+	case fdInfo.value.Desc.IsList():
 		t.P(gen, `Elem: &schema.Schema{`)
 
 		t++
 
 		fdInfo.writeSchemaType(t, gen)
+		fdInfo.writeSchemaValueValidation(t, gen)
+
+		// The list elements are compared with the suppress function of their schema
+		if fdInfo.isDuration() {
+			writeDurationDiffSuppress(t, gen)
+		}
 
 		t--
 
@@ -184,310 +456,946 @@ func (fdInfo *fieldInfo) writeSchemaElement(t tab, gen *protogen.GeneratedFile)
 
 }
 
-func (fdInfo *fieldInfo) writeSchemaOptions(t tab, gen *protogen.GeneratedFile) {
+// writeMapValidation validates the keys of the map and the durations of its values, the SDK
+// does not call the validation functions of the map values
+func (fdInfo *fieldInfo) writeMapValidation(t tab, gen *protogen.GeneratedFile) {
 
-	if fdInfo.value.Enum != nil {
-
-		eInfo := newEnumInfo(fdInfo.value.Enum)
+	validators := []string{}
 
-		eInfo.writeSchemaValidateFunc(t, gen)
+	if pattern, message := fdInfo.mapKeyPattern(); len(pattern) > 0 {
+		validators = append(validators, fmt.Sprintf(`validation.MapKeyMatch(regexp.MustCompile(%q), "%s")`, pattern, message))
 	}
 
-	if fdInfo.schema.DefaultValue != nil {
+	// The SDK does not call the validation of the map element schema
+	if validateFunc := fdInfo.mapValueInfo().valueValidateFunc(); len(validateFunc) > 0 {
+		validators = append(validators, `validation.ToDiagFunc(func(i interface{}, k string) ([]string, []error) {
+	for key, v := range i.(map[string]interface{}) {
+		if _, errs := `+validateFunc+`(v, k+"."+key); len(errs) > 0 {
+			return nil, errs
+		}
+	}
+	return nil, nil
+})`)
+	}
 
-		t.P(gen, `Optional: true,`)
+	switch len(validators) {
 
-		switch val := fdInfo.schema.DefaultValue.Kind.(type) {
+	case 0:
 
-		case *structpb.Value_BoolValue:
-			t.P(gen, `Default: `, fmt.Sprintf("%v", val.BoolValue), `,`)
+	case 1:
+		t.P(gen, `ValidateDiagFunc: `, validators[0], `,`)
 
-		case *structpb.Value_NumberValue:
-			t.P(gen, `Default: `, fmt.Sprintf("%v", val.NumberValue), `,`)
+	default:
+		t.P(gen, `ValidateDiagFunc: validation.AllDiag(`, strings.Join(validators, ", "), `),`)
 
-		case *structpb.Value_StringValue:
+	}
 
-			fieldMsg := fdInfo.value.Desc.Message()
+}
 
-			if fieldMsg != nil && fieldMsg.FullName() == "google.protobuf.Duration" {
+// writeDurationDiffSuppress ignores the different notations of the same duration
+func writeDurationDiffSuppress(t tab, gen *protogen.GeneratedFile) {
 
-				duration, err := time.ParseDuration(val.StringValue)
-				if err != nil {
-					panic(err)
-				}
+	t.P(gen, `DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {`)
+	t++
+	t.P(gen, `o, errOld := time.ParseDuration(oldValue)`)
+	t.P(gen, `n, errNew := time.ParseDuration(newValue)`)
+	t.P(gen, `return errOld == nil && errNew == nil && o == n`)
+	t--
+	t.P(gen, `},`)
 
-				t.P(gen, `Default: time.Duration(`, duration.Nanoseconds(), `),`)
+}
 
-			} else {
-				t.P(gen, "Default: `", val.StringValue, "`,")
-			}
+// writeSchemaSetHash hashes only the key attributes of the set blocks, so changes
+// of the other attributes (computed ones included) do not replace the element
+func (fdInfo *fieldInfo) writeSchemaSetHash(t tab, gen *protogen.GeneratedFile) {
 
-		}
-	} else if fdInfo.schema.Required {
-		t.P(gen, `Required: true,`)
-	} else if fdInfo.schema.Computed {
-		t.P(gen, `Computed: true,`)
-	} else {
-		t.P(gen, `Optional: true,`)
+	if !fdInfo.value.Desc.IsList() || !fdInfo.schema.IsTypeSet || fdInfo.value.Message == nil || isWellKnownMessage(fdInfo.value.Message) {
+		panic(fmt.Sprintf("hash_keys of %s requires a repeated message field with is_type_set", fdInfo.value.Desc.FullName()))
 	}
 
-	if len(fdInfo.value.Comments.Leading) > 0 {
-		t.P(gen, `Description: "`, commentToString(fdInfo.value.Comments.Leading), `",`)
-	}
+	attributes := map[string]*fieldInfo{}
 
-}
+	for _, field := range fdInfo.value.Message.Fields {
 
-func (fdInfo *fieldInfo) writeUnmarshal(t tab, gen *protogen.GeneratedFile, sm selectorMaker) {
+		keyFdInfo := newFieldInfo(fdInfo.fInfo, field)
 
-	selector := sm.makeSelector(fdInfo.fieldKey)
+		if !keyFdInfo.schema.Ignore {
+			attributes[keyFdInfo.fieldKey] = keyFdInfo
+		}
 
-	collectionType := fdInfo.getFieldGoCollectionType()
-	fieldType := fdInfo.getFieldGoType()
+	}
 
-	if len(collectionType) > 0 {
+	formats := []string{}
+	values := []string{}
 
-		switch {
+	for _, key := range fdInfo.schema.HashKeys {
 
-		case fdInfo.value.Desc.IsList():
+		keyFdInfo, ok := attributes[key]
+		if !ok {
+			panic(fmt.Sprintf("hash key %q of %s is not an attribute of %s", key, fdInfo.value.Desc.FullName(), fdInfo.value.Message.Desc.FullName()))
+		}
 
-			t.P(gen, `if `, fdInfo.valueVar, `, `, fdInfo.okVar, ` := `, selector, `.(`, collectionType, `); `, fdInfo.okVar, ` && reflect.ValueOf(`, fdInfo.valueVar, `).IsValid() && !reflect.ValueOf(`, fdInfo.valueVar, `).IsZero()  {`)
+		if keyFdInfo.value.Desc.IsList() || keyFdInfo.value.Desc.IsMap() || keyFdInfo.value.Message != nil {
+			panic(fmt.Sprintf("hash key %q of %s must be a scalar attribute", key, fdInfo.value.Desc.FullName()))
+		}
 
-			t++
+		formats = append(formats, "%v")
+		values = append(values, fmt.Sprintf(`m["%s"]`, key))
 
-			if fdInfo.schema.IsTypeSet {
-				t.P(gen, `list := `, fdInfo.valueVar, `.List()`)
-			} else {
-				t.P(gen, `list := `, fdInfo.valueVar)
+	}
 
-			}
+	t.P(gen, `Set: func(v interface{}) int {`)
+	t++
+	t.P(gen, `m := v.(map[string]interface{})`)
+	t.P(gen, `return schema.HashString(fmt.Sprintf("`, strings.Join(formats, ";"), `", `, strings.Join(values, ", "), `))`)
+	t--
+	t.P(gen, `},`)
 
-			t.P(gen, `r := []`, fieldType, `{}`)
-			t.P(gen, `for _, val := range list {`)
+}
 
-			t++
+// writeSchemaValueValidation validates a single value, enums and 64-bit integers represented as strings
+// valueValidateFunc returns the validation function of a single value, empty when it is not validated
+func (fdInfo *fieldInfo) valueValidateFunc() string {
 
-			switch fdInfo.value.Desc.Kind() {
+	switch {
 
-			case protoreflect.MessageKind:
-				listMInfo := newMessageInfo(fdInfo.fInfo, fdInfo.value.Message)
+	case fdInfo.value.Enum != nil:
+		return newEnumInfo(fdInfo.value.Enum).validateFunc()
 
-				t.P(gen, `m, err :=  `, listMInfo.prefixWithPackage(listMInfo.unmarshalFunctionName), `(val.(map[string]interface{}))`)
-				t.P(gen, `if err != nil {`)
-				t++
-				t.P(gen, `return nil, err`)
-				t--
-				t.P(gen, `}`)
-				t.P(gen, `r = append(r, m)`)
+	case fdInfo.isDuration():
+		return `func(i interface{}, k string) ([]string, []error) {
+	if _, err := time.ParseDuration(i.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s: %w", k, err)}
+	}
+	return nil, nil
+}`
 
-			case protoreflect.BytesKind:
-				t.P(gen, `r = append(r, []byte(val.(`, fieldType, `)))`)
+	case fdInfo.value.Desc.Kind() == protoreflect.BytesKind && !fdInfo.isRawBytes():
+		return `validation.StringIsBase64`
 
-				t.P(gen, `p["`, fdInfo.fieldKey, `"] = []byte(`, fdInfo.valueVar, `)`)
+	}
 
-			default:
-				t.P(gen, `r = append(r, val.(`, fieldType, `))`)
+	return ""
+
+}
+
+func (fdInfo *fieldInfo) writeSchemaValueValidation(t tab, gen *protogen.GeneratedFile) {
+
+	if validateFunc := fdInfo.valueValidateFunc(); len(validateFunc) > 0 {
+		t.P(gen, `ValidateFunc: `, validateFunc, `,`)
+	}
+
+	if fdInfo.isInt64AsString() {
+		t.P(gen, `ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile(`, fmt.Sprintf("%q", fdInfo.int64Pattern()), `), "must be an integer")),`)
+	}
+
+}
+
+func (fdInfo *fieldInfo) writeSchemaOptions(t tab, gen *protogen.GeneratedFile, attr *attributeDescription) {
+
+	// Validation of list elements is written in the element schema
+	if !fdInfo.value.Desc.IsList() {
+		fdInfo.writeSchemaValueValidation(t, gen)
+	}
+
+	attr.writeSchemaBehavior(t, gen)
+
+	if fdInfo.schema.DefaultValue != nil {
+
+		switch val := fdInfo.schema.DefaultValue.Kind.(type) {
+
+		case *structpb.Value_BoolValue:
+			t.P(gen, `Default: `, fmt.Sprintf("%v", val.BoolValue), `,`)
+
+		case *structpb.Value_NumberValue:
+			t.P(gen, `Default: `, fmt.Sprintf("%v", val.NumberValue), `,`)
+
+		case *structpb.Value_StringValue:
+
+			if fdInfo.isDuration() {
+
+				duration, err := time.ParseDuration(val.StringValue)
+				if err != nil {
+					panic(err)
+				}
+
+				t.P(gen, `Default: "`, duration.String(), `",`)
+
+			} else {
+				t.P(gen, "Default: `", val.StringValue, "`,")
+			}
+
+		}
+
+	}
+
+	if len(fdInfo.conflictsWith) > 0 {
+		t.P(gen, `ConflictsWith: []string{"`, strings.Join(fdInfo.conflictsWith, `","`), `"},`)
+	}
+
+	if len(fdInfo.exactlyOneOf) > 0 {
+		t.P(gen, `ExactlyOneOf: []string{"`, strings.Join(fdInfo.exactlyOneOf, `","`), `"},`)
+	}
+
+	if len(fdInfo.schema.HashKeys) > 0 {
+		fdInfo.writeSchemaSetHash(t, gen)
+	}
+
+	fdInfo.writeSchemaDiffSuppress(t, gen)
+
+	if fdInfo.schema.Sensitive {
+		t.P(gen, `Sensitive: true,`)
+	}
+
+	if deprecation := fdInfo.deprecationMessage(); len(deprecation) > 0 {
+		t.P(gen, `Deprecated: "`, deprecation, `",`)
+	}
+
+	description := commentToString(fdInfo.value.Comments.Leading)
+
+	if fdInfo.value.Enum != nil {
+
+		if values := newEnumInfo(fdInfo.value.Enum).valuesDescription(); len(values) > 0 && len(description) > 0 {
+			description = fmt.Sprintf("%s. %s", strings.TrimSuffix(description, "."), values)
+		} else if len(values) > 0 {
+			description = values
+		}
+
+	}
+
+	if len(description) > 0 {
+		t.P(gen, `Description: "`, description, `",`)
+	}
+
+}
+
+func (fdInfo *fieldInfo) writeUnmarshal(t tab, gen *protogen.GeneratedFile, sm selectorMaker) {
+
+	if fdInfo.schema.Ignore {
+		return
+	}
+
+	if !fdInfo.isRecursive() {
+		fdInfo.writeUnmarshalValue(t, gen, sm)
+		return
+	}
+
+	t.P(gen, `if depth > 0 {`)
+	t++
+	fdInfo.writeUnmarshalValue(t, gen, sm)
+	t--
+	t.P(gen, `} else if `, fdInfo.valueVar, `, `, fdInfo.okVar, ` := `, sm.makeSelector(fdInfo.fieldKey), `.(string); `, fdInfo.okVar, ` && len(`, fdInfo.valueVar, `) > 0 {`)
+	t++
+	t.P(gen, `var v interface{}`)
+	t.P(gen, `if err := json.Unmarshal([]byte(`, fdInfo.valueVar, `), &v); err != nil {`)
+	t++
+	t.P(gen, `return nil, err`)
+	t--
+	t.P(gen, `}`)
+	t.P(gen, `p["`, fdInfo.protoKey, `"] = v`)
+	t--
+	t.P(gen, `}`)
+
+}
+
+func (fdInfo *fieldInfo) writeUnmarshalValue(t tab, gen *protogen.GeneratedFile, sm selectorMaker) {
+
+	selector := sm.makeSelector(fdInfo.fieldKey)
+
+	collectionType := fdInfo.getFieldGoCollectionType()
+	fieldType := fdInfo.getFieldGoType()
+
+	if len(collectionType) > 0 {
+
+		switch {
+
+		case fdInfo.value.Desc.IsList():
+
+			t.P(gen, `if `, fdInfo.valueVar, `, `, fdInfo.okVar, ` := `, selector, `.(`, collectionType, `); `, fdInfo.okVar, ` && reflect.ValueOf(`, fdInfo.valueVar, `).IsValid() && !reflect.ValueOf(`, fdInfo.valueVar, `).IsZero()  {`)
+
+			t++
+
+			if fdInfo.schema.IsTypeSet {
+				t.P(gen, `list := `, fdInfo.valueVar, `.List()`)
+			} else {
+				t.P(gen, `list := `, fdInfo.valueVar)
 
 			}
 
-			t--
+			// Special float values are sent as strings
+			if fdInfo.isFloat() {
+				t.P(gen, `r := []interface{}{}`)
+			} else {
+				t.P(gen, `r := []`, fieldType, `{}`)
+			}
+
+			block := fdInfo.value.Message != nil && !isWellKnownMessage(fdInfo.value.Message)
+
+			if block && !fdInfo.schema.IsTypeSet {
+				t.P(gen, `for i, val := range list {`)
+			} else {
+				t.P(gen, `for _, val := range list {`)
+			}
+
+			t++
+
+			switch {
+
+			case block:
+				listMInfo := newMessageInfo(fdInfo.fInfo, fdInfo.value.Message)
+
+				if fdInfo.schema.IsTypeSet {
+					writeBlockConfig(t, gen, sm.getConfigVar(), fdInfo.fieldKey, `itemConfig`, ``)
+				} else {
+					writeBlockConfig(t, gen, sm.getConfigVar(), fdInfo.fieldKey, `itemConfig`, `i`)
+				}
+
+				// Blocks without any value are nil
+				t.P(gen, `e, _ := val.(map[string]interface{})`)
+				t.P(gen, `m, err :=  `, fdInfo.messageFunctionCall(listMInfo, listMInfo.unmarshalConfigFunctionName, `e`, `itemConfig`))
+				t.P(gen, `if err != nil {`)
+				t++
+				t.P(gen, `return nil, err`)
+				t--
+				t.P(gen, `}`)
+				t.P(gen, `r = append(r, m)`)
+
+			default:
+				fdInfo.writeToProtoValue(t, gen, `val.(`+fieldType+`)`, func(t tab, expr string) {
+					t.P(gen, `r = append(r, `, expr, `)`)
+				})
+
+			}
+
+			t--
+
+			t.P(gen, `}`)
+			t.P(gen, `p["`, fdInfo.protoKey, `"] = r`)
+
+			t--
+
+			t.P(gen, `}`)
+
+		case fdInfo.isMessageMap():
+
+			t.P(gen, `if `, fdInfo.valueVar, `, `, fdInfo.okVar, ` := `, selector, `.(`, collectionType, `); `, fdInfo.okVar, ` && `, fdInfo.valueVar, `.Len() > 0 {`)
+
+			t++
+
+			mapMInfo := newMessageInfo(fdInfo.fInfo, fieldMessage(fdInfo.value))
+
+			t.P(gen, `m := map[string]interface{}{}`)
+			t.P(gen, `for _, val := range `, fdInfo.valueVar, `.List() {`)
+
+			t++
+
+			t.P(gen, `e := val.(map[string]interface{})`)
+
+			writeBlockConfig(t, gen, sm.getConfigVar(), fdInfo.fieldKey, `itemConfig`, ``)
+
+			t.P(gen, `v, err := `, fdInfo.messageFunctionCall(mapMInfo, mapMInfo.unmarshalConfigFunctionName, `e`, `itemConfig`))
+			t.P(gen, `if err != nil {`)
+			t++
+			t.P(gen, `return nil, err`)
+			t--
+			t.P(gen, `}`)
+			t.P(gen, `k := e["`, fdInfo.mapKeyName(), `"].(string)`)
+
+			key := fdInfo.writeMapKeyConversion(t, gen, `k`)
+
+			t.P(gen, `m[`, key, `] = v`)
+
+			t--
+
+			t.P(gen, `}`)
+			t.P(gen, `p["`, fdInfo.protoKey, `"] = m`)
+
+			t--
+
+			t.P(gen, `}`)
+
+		case fdInfo.value.Desc.IsMap():
+
+			t.P(gen, `if `, fdInfo.valueVar, `, `, fdInfo.okVar, ` := `, selector, `.(`, collectionType, `); `, fdInfo.okVar, ` && len(`, fdInfo.valueVar, `) > 0 {`)
+
+			t++
+
+			valueInfo := fdInfo.mapValueInfo()
+			valueType := valueInfo.getFieldGoType()
+
+			t.P(gen, `m := map[string]interface{}{}`)
+			t.P(gen, `for k, v := range `, fdInfo.valueVar, ` {`)
+
+			t++
+
+			key := fdInfo.writeMapKeyConversion(t, gen, `k`)
+
+			valueInfo.writeToProtoValue(t, gen, `v.(`+valueType+`)`, func(t tab, expr string) {
+				t.P(gen, `m[`, key, `] = `, expr)
+			})
+
+			t--
+
+			t.P(gen, `}`)
+			t.P(gen, `p["`, fdInfo.protoKey, `"] = m`)
+
+			t--
+
+			t.P(gen, `}`)
+
+		case fdInfo.value.Desc.Kind() == protoreflect.MessageKind:
+
+			t.P(gen, `if `, fdInfo.valueVar, `Collection, `, fdInfo.okVar, ` := `, selector, `.(`, collectionType, `); `, fdInfo.okVar, ` && reflect.ValueOf(`, fdInfo.valueVar, `Collection).IsValid() && !reflect.ValueOf(`, fdInfo.valueVar, `Collection).IsZero() && len(`, fdInfo.valueVar, `Collection) > 0 {`)
+
+			t++
+
+			t.P(gen, `if `, fdInfo.valueVar, `, `, fdInfo.okVar, ` := `, fdInfo.valueVar, `Collection[0].(`, fieldType, `); `, fdInfo.okVar, ` {`)
+
+			t++
+
+			fieldMessageInfo := newMessageInfo(fdInfo.fInfo, fdInfo.value.Message)
+
+			writeBlockConfig(t, gen, sm.getConfigVar(), fdInfo.fieldKey, `msgConfig`, `0`)
+
+			t.P(gen, `msg, err := `, fdInfo.messageFunctionCall(fieldMessageInfo, fieldMessageInfo.unmarshalConfigFunctionName, fdInfo.valueVar, `msgConfig`))
+			t.P(gen, `if err != nil {`)
+			t++
+			t.P(gen, `return nil, err`)
+			t--
+			t.P(gen, `}`)
+
+			t.P(gen, `p["`, fdInfo.protoKey, `"] = msg`)
+
+			t--
+
+			t.P(gen, `}`)
+
+			t--
+
+			t.P(gen, `}`)
+
+		}
+
+		return
+	}
+
+	switch fdInfo.value.Desc.Kind() {
+
+	case protoreflect.MessageKind:
+
+		switch fdInfo.value.Desc.Message().FullName() {
+
+		case wellKnownDuration:
+
+			t.P(gen, `if `, fdInfo.valueVar, `, `, fdInfo.okVar, ` := `, selector, `.(string); `, fdInfo.okVar, ` && reflect.ValueOf(`, fdInfo.valueVar, `).IsValid() && !reflect.ValueOf(`, fdInfo.valueVar, `).IsZero() {`)
+
+			t++
+
+			fdInfo.writeToProtoValue(t, gen, fdInfo.valueVar, func(t tab, expr string) {
+				t.P(gen, `p["`, fdInfo.protoKey, `"] = `, expr)
+			})
+
+			t--
+
+			t.P(gen, `}`)
+
+		}
+
+	case protoreflect.BoolKind, protoreflect.StringKind, protoreflect.EnumKind, protoreflect.BytesKind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind, protoreflect.Fixed32Kind,
+		protoreflect.Fixed64Kind, protoreflect.Int32Kind, protoreflect.Int64Kind,
+		protoreflect.Sint32Kind, protoreflect.Sint64Kind, protoreflect.Uint32Kind,
+		protoreflect.Uint64Kind, protoreflect.DoubleKind, protoreflect.FloatKind:
+
+		// Fields with presence keep their explicit zero values
+		if fdInfo.hasPresence() {
+
+			t.P(gen, `if `, fdInfo.valueVar, `, `, fdInfo.okVar, ` := `, selector, `.(`, fieldType, `); `, fdInfo.presenceCondition(sm.getConfigVar()), ` {`)
+
+			t++
+
+			fdInfo.writeToProtoValue(t, gen, fdInfo.valueVar, func(t tab, expr string) {
+				t.P(gen, `p["`, fdInfo.protoKey, `"] = `, expr)
+			})
+
+			t--
+
+			t.P(gen, `}`)
+
+			return
+
+		}
+
+		t.P(gen, `if `, fdInfo.valueVar, `, `, fdInfo.okVar, ` := `, selector, `.(`, fieldType, `); `, fdInfo.okVar, ` && reflect.ValueOf(`, fdInfo.valueVar, `).IsValid() && !reflect.ValueOf(`, fdInfo.valueVar, `).IsZero() {`)
+
+		t++
+
+		fdInfo.writeToProtoValue(t, gen, fdInfo.valueVar, func(t tab, expr string) {
+			t.P(gen, `p["`, fdInfo.protoKey, `"] = `, expr)
+		})
+
+		t--
+
+		t.P(gen, `}`)
+
+	}
+
+}
+
+func (fdInfo *fieldInfo) getFieldGoCollectionType() string {
+
+	msg := fdInfo.value.Desc.Message()
+
+	switch {
+
+	case fdInfo.value.Desc.IsList():
+
+		if fdInfo.schema.IsTypeSet {
+			return "*schema.Set"
+		}
+
+		return "[]interface{}"
+
+	case fdInfo.isMessageMap():
+
+		return "*schema.Set"
+
+	case fdInfo.value.Desc.IsMap():
+
+		return "map[string]interface{}"
+
+	case fdInfo.value.Desc.Kind() == protoreflect.MessageKind:
+
+		switch msg.FullName() {
+
+		case wellKnownDuration:
+			return ""
+
+		default:
+			return "[]interface{}"
+
+		}
+
+	}
+
+	return ""
+
+}
+func (fdInfo *fieldInfo) getFieldGoType() string {
+
+	msg := fdInfo.value.Desc.Message()
+
+	switch fdInfo.value.Desc.Kind() {
+
+	case protoreflect.BoolKind:
+		return "bool"
+
+	case protoreflect.BytesKind:
+		return "string"
+
+	case protoreflect.StringKind:
+		return "string"
+
+	case protoreflect.EnumKind:
+
+		if newEnumInfo(fdInfo.value.Enum).schema.AsInt {
+			return "int"
+		}
+
+		return "string"
+
+	case protoreflect.MessageKind:
+
+		switch msg.FullName() {
+
+		case wellKnownDuration:
+			return "string"
+
+		default:
+			return "map[string]interface{}"
+
+		}
+
+	case protoreflect.Uint64Kind, protoreflect.Uint32Kind,
+		protoreflect.Sfixed64Kind, protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Fixed64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Fixed32Kind:
+
+		if fdInfo.isInt64AsString() {
+			return "string"
+		}
+
+		return "int"
+
+	case
+		protoreflect.FloatKind, protoreflect.DoubleKind:
+		return "float64"
+
+	}
+
+	return "any"
+
+}
+
+func (fdInfo *fieldInfo) writeMarshal(t tab, gen *protogen.GeneratedFile, mi mapIndexMaker) {
+
+	if fdInfo.schema.Ignore {
+		return
+	}
+
+	if !fdInfo.isRecursive() {
+		fdInfo.writeMarshalValue(t, gen, mi)
+		return
+	}
+
+	t.P(gen, `if depth > 0 {`)
+	t++
+	fdInfo.writeMarshalValue(t, gen, mi)
+	t--
+	t.P(gen, `} else if v, ok := obj["`, fdInfo.protoKey, `"]; ok {`)
+	t++
+	t.P(gen, `b, err := json.Marshal(v)`)
+	t.P(gen, `if err != nil {`)
+	t++
+	t.P(gen, `return nil, err`)
+	t--
+	t.P(gen, `}`)
+	t.P(gen, mi.makeMapIndex(fdInfo.fieldKey), ` = string(b)`)
+	t--
+	t.P(gen, `}`)
+
+}
 
-			t.P(gen, `}`)
-			t.P(gen, `p["`, fdInfo.fieldKey, `"] = r`)
+// isRawBytes reports if the bytes are UTF-8 strings in terraform instead of base64
+func (fdInfo *fieldInfo) isRawBytes() bool {
+	return fdInfo.value.Desc.Kind() == protoreflect.BytesKind && fdInfo.schema.BytesEncoding == terraformpb.BytesEncoding_BYTES_ENCODING_RAW
+}
 
-			t--
+// writeUpdateMaskPath adds the proto path of the field when its attribute changed, nested
+// blocks add the paths of their changed fields unless the block itself is added or removed
+func (fdInfo *fieldInfo) writeUpdateMaskPath(t tab, gen *protogen.GeneratedFile, key, path string) {
 
-			t.P(gen, `}`)
+	t.P(gen, `if rd.HasChange("`, key, `") {`)
 
-		case fdInfo.value.Desc.IsMap():
+	t++
 
-			t.P(gen, `if `, fdInfo.valueVar, `, `, fdInfo.okVar, ` := `, selector, `.(`, collectionType, `); `, fdInfo.okVar, `{`)
+	nested := fdInfo.value.Message != nil && !isWellKnownMessage(fdInfo.value.Message) &&
+		!fdInfo.value.Desc.IsList() && !fdInfo.value.Desc.IsMap() && !fdInfo.isRecursive()
 
-			t++
+	if nested {
 
-			mapValue := fdInfo.value.Desc.MapValue()
+		t.P(gen, `if o, n := rd.GetChange("`, key, `"); len(o.([]interface{})) != len(n.([]interface{})) {`)
+		t++
+		t.P(gen, `paths = append(paths, "`, path, `")`)
+		t--
+		t.P(gen, `} else {`)
+		t++
+		newMessageInfo(fdInfo.fInfo, fdInfo.value.Message).writeUpdateMaskPaths(t, gen, key+".0.", path+".")
+		t--
+		t.P(gen, `}`)
 
-			t.P(gen, `m := map[string]interface{}`)
-			t.P(gen, `for k, v := range `, fdInfo.valueVar, ` {`)
+	} else {
+		t.P(gen, `paths = append(paths, "`, path, `")`)
+	}
 
-			t++
+	t--
 
-			switch mapValue.Kind() {
+	t.P(gen, `}`)
 
-			case protoreflect.MessageKind:
-				mapMInfo := newMessageInfo(fdInfo.fInfo, fdInfo.value.Message)
+}
 
-				t.P(gen, `m[k] = `, mapMInfo.prefixWithPackage(mapMInfo.unmarshalFunctionName), `(v.(map[string]interface{}))`)
+func (fdInfo *fieldInfo) isDuration() bool {
+	return fdInfo.value.Message != nil && fdInfo.value.Message.Desc.FullName() == wellKnownDuration
+}
 
-			default:
-				t.P(gen, `m[k] = v.(`, fieldType, `)`)
+func (fdInfo *fieldInfo) isFloat() bool {
 
-			}
+	kind := fdInfo.value.Desc.Kind()
 
-			t--
+	return kind == protoreflect.FloatKind || kind == protoreflect.DoubleKind
 
-			t.P(gen, `}`)
-			t.P(gen, `p["`, fdInfo.fieldKey, `"] = m`)
+}
 
-			t--
+// writeToProtoValue converts a terraform value, durations are converted to seconds, special float
+// values are replaced by the strings of protojson (json.Marshal rejects them) and float32 overflows
+// are reported
+func (fdInfo *fieldInfo) writeToProtoValue(t tab, gen *protogen.GeneratedFile, expr string, assign func(t tab, expr string)) {
 
-			t.P(gen, `}`)
+	// protojson only accepts seconds with the s suffix
+	if fdInfo.isDuration() {
+		t.P(gen, `d, err := time.ParseDuration(`, expr, `)`)
+		t.P(gen, `if err != nil {`)
+		t++
+		t.P(gen, `return nil, err`)
+		t--
+		t.P(gen, `}`)
+		assign(t, `strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"`)
+		return
+	}
 
-		case fdInfo.value.Desc.Kind() == protoreflect.MessageKind:
+	if fdInfo.value.Enum != nil {
+		newEnumInfo(fdInfo.value.Enum).writeToProto(t, gen, expr, assign)
+		return
+	}
 
-			t.P(gen, `if `, fdInfo.valueVar, `Collection, `, fdInfo.okVar, ` := `, selector, `.(`, collectionType, `); `, fdInfo.okVar, ` && reflect.ValueOf(`, fdInfo.valueVar, `Collection).IsValid() && !reflect.ValueOf(`, fdInfo.valueVar, `Collection).IsZero() && len(`, fdInfo.valueVar, `Collection) > 0 {`)
+	if fdInfo.isRawBytes() {
+		assign(t, `base64.StdEncoding.EncodeToString([]byte(`+expr+`))`)
+		return
+	}
 
-			t++
+	if !fdInfo.isFloat() {
+		assign(t, expr)
+		return
+	}
 
-			t.P(gen, `if `, fdInfo.valueVar, `, `, fdInfo.okVar, ` := `, fdInfo.valueVar, `Collection[0].(`, fieldType, `); `, fdInfo.okVar, ` {`)
+	t.P(gen, `switch f := `, expr, `; {`)
 
-			t++
+	t.P(gen, `case math.IsNaN(f):`)
+	assign(t+1, `"NaN"`)
 
-			fieldMessageInfo := newMessageInfo(fdInfo.fInfo, fdInfo.value.Message)
+	t.P(gen, `case math.IsInf(f, 1):`)
+	assign(t+1, `"Infinity"`)
 
-			t.P(gen, `msg, err := `, fieldMessageInfo.prefixWithPackage(fieldMessageInfo.unmarshalFunctionName), `(`, fdInfo.valueVar, `)`)
-			t.P(gen, `if err != nil {`)
-			t++
-			t.P(gen, `return nil, err`)
-			t--
-			t.P(gen, `}`)
+	t.P(gen, `case math.IsInf(f, -1):`)
+	assign(t+1, `"-Infinity"`)
 
-			t.P(gen, `p["`, fdInfo.fieldKey, `"] = msg`)
+	if fdInfo.value.Desc.Kind() == protoreflect.FloatKind {
+		t.P(gen, `case math.Abs(f) > math.MaxFloat32:`)
+		t++
+		t.P(gen, `return nil, fmt.Errorf("%v of `, fdInfo.fieldKey, ` overflows a float32", f)`)
+		t--
+	}
 
-			t--
+	t.P(gen, `default:`)
+	assign(t+1, `f`)
 
-			t.P(gen, `}`)
+	t.P(gen, `}`)
 
-			t--
+}
 
-			t.P(gen, `}`)
+// writeSchemaDiffSuppress ignores the float32 rounding of the values read back from the API
+// and the different notations of the same duration
+func (fdInfo *fieldInfo) writeSchemaDiffSuppress(t tab, gen *protogen.GeneratedFile) {
 
-		}
+	// Durations of lists and maps are suppressed with their elements
+	if fdInfo.isDuration() && !fdInfo.value.Desc.IsList() {
+		writeDurationDiffSuppress(t, gen)
+	}
 
+	if !fdInfo.schema.SuppressFloat32Rounding {
 		return
 	}
 
-	switch fdInfo.value.Desc.Kind() {
+	if !fdInfo.isFloat() {
+		panic(fmt.Sprintf("suppress_float32_rounding of %s is only supported on float and double fields", fdInfo.value.Desc.FullName()))
+	}
 
-	case protoreflect.MessageKind:
+	t.P(gen, `DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {`)
+	t++
+	t.P(gen, `o, errOld := strconv.ParseFloat(oldValue, 32)`)
+	t.P(gen, `n, errNew := strconv.ParseFloat(newValue, 32)`)
+	t.P(gen, `return errOld == nil && errNew == nil && o == n`)
+	t--
+	t.P(gen, `},`)
 
-		switch fdInfo.value.Desc.Message().FullName() {
+}
 
-		case wellKnownDuration:
+// writeDurationMarshal converts the protojson seconds into the canonical duration of terraform
+func (fdInfo *fieldInfo) writeDurationMarshal(t tab, gen *protogen.GeneratedFile, src string, assign func(t tab, expr string)) {
 
-			t.P(gen, `if `, fdInfo.valueVar, `, `, fdInfo.okVar, ` := `, selector, `.(string); `, fdInfo.okVar, ` && reflect.ValueOf(`, fdInfo.valueVar, `).IsValid() && !reflect.ValueOf(`, fdInfo.valueVar, `).IsZero() {`)
+	t.P(gen, `if s, ok := `, src, `.(string); ok {`)
+	t++
+	t.P(gen, `duration, err := time.ParseDuration(s)`)
+	t.P(gen, `if err != nil {`)
+	t++
+	t.P(gen, `return nil, err`)
+	t--
+	t.P(gen, `}`)
+	assign(t, `duration.String()`)
+	t--
+	t.P(gen, `}`)
 
-			t++
+}
 
-			t.P(gen, `p["`, fdInfo.fieldKey, `"] = `, fdInfo.valueVar)
+// writeRawBytesMarshal decodes the protojson base64 into the raw terraform string
+func (fdInfo *fieldInfo) writeRawBytesMarshal(t tab, gen *protogen.GeneratedFile, src string, assign func(t tab, expr string)) {
 
-			t--
+	t.P(gen, `if s, ok := `, src, `.(string); ok {`)
+	t++
+	t.P(gen, `b, err := base64.StdEncoding.DecodeString(s)`)
+	t.P(gen, `if err != nil {`)
+	t++
+	t.P(gen, `return nil, err`)
+	t--
+	t.P(gen, `}`)
+	assign(t, `string(b)`)
+	t--
+	t.P(gen, `}`)
 
-			t.P(gen, `}`)
+}
 
-		}
+func (fdInfo *fieldInfo) isNumber() bool {
 
-	case protoreflect.BoolKind, protoreflect.StringKind, protoreflect.EnumKind, protoreflect.BytesKind,
-		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind, protoreflect.Fixed32Kind,
+	switch fdInfo.value.Desc.Kind() {
+
+	case protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind, protoreflect.Fixed32Kind,
 		protoreflect.Fixed64Kind, protoreflect.Int32Kind, protoreflect.Int64Kind,
 		protoreflect.Sint32Kind, protoreflect.Sint64Kind, protoreflect.Uint32Kind,
 		protoreflect.Uint64Kind, protoreflect.DoubleKind, protoreflect.FloatKind:
+		return true
 
-		t.P(gen, `if `, fdInfo.valueVar, `, `, fdInfo.okVar, ` := `, selector, `.(`, fieldType, `); `, fdInfo.okVar, ` && reflect.ValueOf(`, fdInfo.valueVar, `).IsValid() && !reflect.ValueOf(`, fdInfo.valueVar, `).IsZero() {`)
+	}
 
-		t++
+	return false
 
-		t.P(gen, `p["`, fdInfo.fieldKey, `"] = `, fdInfo.valueVar)
+}
 
-		t--
+// is64Bit reports if the field holds 64-bit integers, which protojson encodes as strings
+func (fdInfo *fieldInfo) is64Bit() bool {
 
-		t.P(gen, `}`)
+	switch fdInfo.value.Desc.Kind() {
+
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return true
 
 	}
 
+	return false
+
 }
 
-func (fdInfo *fieldInfo) getFieldGoCollectionType() string {
+func (fdInfo *fieldInfo) isInt64AsString() bool {
 
-	msg := fdInfo.value.Desc.Message()
+	if !fdInfo.schema.Int64AsString {
+		return false
+	}
 
-	switch {
+	if !fdInfo.is64Bit() {
+		panic(fmt.Sprintf("int64_as_string of %s is only supported on 64-bit integer fields", fdInfo.value.Desc.FullName()))
+	}
 
-	case fdInfo.value.Desc.IsList():
+	return true
 
-		if fdInfo.schema.IsTypeSet {
-			return "*schema.Set"
-		}
+}
 
-		return "[]interface{}"
+func (fdInfo *fieldInfo) int64Pattern() string {
 
-	case fdInfo.value.Desc.IsMap():
+	switch fdInfo.value.Desc.Kind() {
 
-		return "map[string]interface{}"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return `^[0-9]+$`
 
-	case fdInfo.value.Desc.Kind() == protoreflect.MessageKind:
+	}
 
-		switch msg.FullName() {
+	return `^-?[0-9]+$`
 
-		case wellKnownDuration:
-			return ""
+}
 
-		default:
-			return "[]interface{}"
+// writeNumberMarshal converts a number decoded from protojson, 64-bit integers are strings
+// and the other numbers are json.Number, or float64 when the caller decoded without UseNumber
+func (fdInfo *fieldInfo) writeNumberMarshal(t tab, gen *protogen.GeneratedFile, src string, assign func(t tab, expr string)) {
 
-		}
+	if fdInfo.isInt64AsString() {
+
+		t.P(gen, `if s, ok := `, src, `.(string); ok {`)
+		t++
+		assign(t, `s`)
+		t--
+		t.P(gen, `}`)
+
+		return
 
 	}
 
-	return ""
+	switch fdInfo.value.Desc.Kind() {
 
-}
-func (fdInfo *fieldInfo) getFieldGoType() string {
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
 
-	msg := fdInfo.value.Desc.Message()
+		t.P(gen, `if s, ok := `, src, `.(string); ok {`)
+		t++
+		t.P(gen, `n, err := strconv.ParseInt(s, 10, 64)`)
+		t.P(gen, `if err != nil {`)
+		t++
+		t.P(gen, `return nil, err`)
+		t--
+		t.P(gen, `}`)
+		assign(t, `n`)
+		t--
+		t.P(gen, `}`)
 
-	switch fdInfo.value.Desc.Kind() {
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
 
-	case protoreflect.BoolKind:
-		return "bool"
+		t.P(gen, `if s, ok := `, src, `.(string); ok {`)
+		t++
+		t.P(gen, `n, err := strconv.ParseUint(s, 10, 64)`)
+		t.P(gen, `if err != nil {`)
+		t++
+		t.P(gen, `return nil, err`)
+		t--
+		t.P(gen, `}`)
+		// int64_as_string is not supported on map values
+		hint := ", use int64_as_string"
 
-	case protoreflect.BytesKind:
-		return "[]byte"
+		if fdInfo.value.Desc.ContainingMessage().IsMapEntry() {
+			hint = ", map values are limited to the int64 range"
+		}
 
-	case protoreflect.StringKind, protoreflect.EnumKind:
-		return "string"
+		t.P(gen, `if int64(n) < 0 {`)
+		t++
+		t.P(gen, `return nil, fmt.Errorf("%d of `, fdInfo.fieldKey, ` overflows a terraform integer`, hint, `", n)`)
+		t--
+		t.P(gen, `}`)
+		assign(t, `int64(n)`)
+		t--
+		t.P(gen, `}`)
 
-	case protoreflect.MessageKind:
+	case protoreflect.DoubleKind, protoreflect.FloatKind:
 
-		switch msg.FullName() {
+		// Special values are the NaN, Infinity and -Infinity strings
+		t.P(gen, `switch s := `, src, `.(type) {`)
 
-		case wellKnownDuration:
-			return "string"
+		for _, c := range []struct{ typeName, value string }{{"json.Number", "string(s)"}, {"string", "s"}} {
 
-		default:
-			return "map[string]interface{}"
+			t.P(gen, `case `, c.typeName, `:`)
+			t++
+			t.P(gen, `n, err := strconv.ParseFloat(`, c.value, `, 64)`)
+			t.P(gen, `if err != nil {`)
+			t++
+			t.P(gen, `return nil, err`)
+			t--
+			t.P(gen, `}`)
+			assign(t, `n`)
+			t--
 
 		}
 
-	case protoreflect.Uint64Kind, protoreflect.Uint32Kind,
-		protoreflect.Sfixed64Kind, protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Fixed64Kind,
-		protoreflect.Sfixed32Kind, protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Fixed32Kind:
-		return "int"
+		t.P(gen, `case float64:`)
+		assign(t+1, `s`)
 
-	case
-		protoreflect.FloatKind:
-		return "float32"
+		t.P(gen, `}`)
 
-	case
-		protoreflect.DoubleKind:
-		return "float64"
+	default:
 
-	}
+		t.P(gen, `switch s := `, src, `.(type) {`)
+		t.P(gen, `case json.Number:`)
+		t++
+		t.P(gen, `n, err := s.Int64()`)
+		t.P(gen, `if err != nil {`)
+		t++
+		t.P(gen, `return nil, err`)
+		t--
+		t.P(gen, `}`)
+		assign(t, `n`)
+		t--
+		t.P(gen, `case float64:`)
+		assign(t+1, `int64(s)`)
+		t.P(gen, `}`)
 
-	return "any"
+	}
 
 }
 
-func (fdInfo *fieldInfo) writeMarshal(t tab, gen *protogen.GeneratedFile, mi mapIndexMaker) {
+func (fdInfo *fieldInfo) writeMarshalValue(t tab, gen *protogen.GeneratedFile, mi mapIndexMaker) {
 
 	collectionType := fdInfo.getFieldGoCollectionType()
 	fieldType := fdInfo.getFieldGoType()
@@ -500,7 +1408,7 @@ func (fdInfo *fieldInfo) writeMarshal(t tab, gen *protogen.GeneratedFile, mi map
 
 		case fdInfo.value.Desc.IsList():
 
-			t.P(gen, `if l, ok := obj["`, fdInfo.fieldKey, `"].([]interface{}); ok {`)
+			t.P(gen, `if l, ok := obj["`, fdInfo.protoKey, `"].([]interface{}); ok {`)
 
 			t++
 
@@ -509,69 +1417,142 @@ func (fdInfo *fieldInfo) writeMarshal(t tab, gen *protogen.GeneratedFile, mi map
 
 			t++
 
+			appendItem := func(t tab, expr string) {
+				t.P(gen, mapIndex, ` = append(`, mapIndex, `.([]interface{}), `, expr, `)`)
+			}
+
 			switch {
 
+			case fdInfo.isDuration():
+				fdInfo.writeDurationMarshal(t, gen, `i`, appendItem)
+
 			case fdInfo.value.Desc.Kind() == protoreflect.MessageKind:
 
 				mInfo := newMessageInfo(fdInfo.fInfo, fdInfo.value.Message)
 
-				t.P(gen, `d, err := `, mInfo.prefixWithPackage(mInfo.marshalFunctionName), `(i.(map[string]interface{}))`)
+				t.P(gen, `d, err := `, fdInfo.messageFunctionCall(mInfo, mInfo.marshalFunctionName, `i.(map[string]interface{})`))
 				t.P(gen, `if err != nil {`)
 				t++
 				t.P(gen, `return nil, err`)
 				t--
 				t.P(gen, `}`)
 
+				appendItem(t, `d`)
+
+			case fdInfo.value.Desc.Kind() == protoreflect.EnumKind:
+				newEnumInfo(fdInfo.value.Enum).writeFromProto(t, gen, `i`, appendItem)
+
+			case fdInfo.isNumber():
+				fdInfo.writeNumberMarshal(t, gen, `i`, appendItem)
+
+			case fdInfo.isRawBytes():
+				fdInfo.writeRawBytesMarshal(t, gen, `i`, appendItem)
+
 			default:
-				t.P(gen, `d := i.(`, fieldType, `)`)
+				appendItem(t, `i.(`+fieldType+`)`)
 
 			}
 
-			t.P(gen, `p["`, fdInfo.fieldKey, `"] = append(p["`, fdInfo.fieldKey, `"].([]interface{}), d)`)
+			t--
+
+			t.P(gen, `}`)
+
+			t--
+
+			t.P(gen, `}`)
+
+		case fdInfo.isMessageMap():
+
+			t.P(gen, `if m, ok := obj["`, fdInfo.protoKey, `"].(map[string]interface{}); ok {`)
+
+			t++
+
+			mInfo := newMessageInfo(fdInfo.fInfo, fieldMessage(fdInfo.value))
+
+			t.P(gen, `l := []interface{}{}`)
+			t.P(gen, `for k, v := range m {`)
+
+			t++
+
+			t.P(gen, `d, err := `, fdInfo.messageFunctionCall(mInfo, mInfo.marshalFunctionName, `v.(map[string]interface{})`))
+			t.P(gen, `if err != nil {`)
+			t++
+			t.P(gen, `return nil, err`)
+			t--
+			t.P(gen, `}`)
+			t.P(gen, `d["`, fdInfo.mapKeyName(), `"] = `, fdInfo.writeMapKeyConversion(t, gen, `k`))
+			t.P(gen, `l = append(l, d)`)
 
 			t--
 
 			t.P(gen, `}`)
 
+			t.P(gen, mapIndex, ` = l`)
+
 			t--
 
 			t.P(gen, `}`)
 
 		case fdInfo.value.Desc.IsMap():
 
-			t.P(gen, `if m, ok := obj["`, fdInfo.fieldKey, `"].(map[string]interface{}); ok {`)
+			t.P(gen, `if m, ok := obj["`, fdInfo.protoKey, `"].(map[string]interface{}); ok {`)
 
 			t++
 
-			t.P(gen, `p["`, fdInfo.fieldKey, `"] = map[string]interface{}{}`)
+			valueInfo := fdInfo.mapValueInfo()
+
+			t.P(gen, `d := map[string]interface{}{}`)
 			t.P(gen, `for k, v := range m {`)
 
 			t++
 
-			switch {
+			key := fdInfo.writeMapKeyConversion(t, gen, `k`)
 
-			case fdInfo.value.Desc.Kind() == protoreflect.MessageKind:
+			switch valueInfo.value.Desc.Kind() {
 
-				mInfo := newMessageInfo(fdInfo.fInfo, fdInfo.value.Message)
+			case protoreflect.EnumKind:
+				newEnumInfo(valueInfo.value.Enum).writeFromProto(t, gen, `v`, func(t tab, expr string) {
+					t.P(gen, `d[`, key, `] = `, expr)
+				})
 
-				t.P(gen, `d, err := `, mInfo.prefixWithPackage(mInfo.marshalFunctionName), `(i.(map[string]interface{}))`)
-				t.P(gen, `if err != nil {`)
-				t++
-				t.P(gen, `return nil, err`)
-				t--
-				t.P(gen, `}`)
+			case protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind, protoreflect.Fixed32Kind,
+				protoreflect.Fixed64Kind, protoreflect.Int32Kind, protoreflect.Int64Kind,
+				protoreflect.Sint32Kind, protoreflect.Sint64Kind, protoreflect.Uint32Kind,
+				protoreflect.Uint64Kind, protoreflect.DoubleKind, protoreflect.FloatKind:
+
+				valueInfo.writeNumberMarshal(t, gen, `v`, func(t tab, expr string) {
+					t.P(gen, `d[`, key, `] = `, expr)
+				})
+
+			case protoreflect.BytesKind:
+
+				if valueInfo.isRawBytes() {
+					valueInfo.writeRawBytesMarshal(t, gen, `v`, func(t tab, expr string) {
+						t.P(gen, `d[`, key, `] = `, expr)
+					})
+					break
+				}
+
+				t.P(gen, `d[`, key, `] = v`)
+
+			case protoreflect.MessageKind:
+
+				// Maps of messages are sets of blocks, the values are durations
+				valueInfo.writeDurationMarshal(t, gen, `v`, func(t tab, expr string) {
+					t.P(gen, `d[`, key, `] = `, expr)
+				})
 
 			default:
-				t.P(gen, `d := i.(`, fieldType, `)`)
+				t.P(gen, `d[`, key, `] = v`)
 
 			}
 
-			t.P(gen, mapIndex, `[k] = d`)
-
 			t--
 
 			t.P(gen, `}`)
 
+			t.P(gen, mapIndex, ` = d`)
+
 			t--
 
 			t.P(gen, `}`)
@@ -580,11 +1561,11 @@ func (fdInfo *fieldInfo) writeMarshal(t tab, gen *protogen.GeneratedFile, mi map
 
 			mInfo := newMessageInfo(fdInfo.fInfo, fdInfo.value.Message)
 
-			t.P(gen, `if m, ok := obj["`, fdInfo.fieldKey, `"].(map[string]interface{}); ok {`)
+			t.P(gen, `if m, ok := obj["`, fdInfo.protoKey, `"].(map[string]interface{}); ok {`)
 
 			t++
 
-			t.P(gen, `d, err := `, mInfo.prefixWithPackage(mInfo.marshalFunctionName), `(m)`)
+			t.P(gen, `d, err := `, fdInfo.messageFunctionCall(mInfo, mInfo.marshalFunctionName, `m`))
 			t.P(gen, `if err != nil {`)
 			t++
 			t.P(gen, `return nil, err`)
@@ -607,7 +1588,9 @@ func (fdInfo *fieldInfo) writeMarshal(t tab, gen *protogen.GeneratedFile, mi map
 		switch fdInfo.value.Desc.Message().FullName() {
 
 		case wellKnownDuration:
-			t.P(gen, mapIndex, `, _ = obj["`, fdInfo.fieldKey, `"].(string)`)
+			fdInfo.writeDurationMarshal(t, gen, `obj["`+fdInfo.protoKey+`"]`, func(t tab, expr string) {
+				t.P(gen, mapIndex, ` = `, expr)
+			})
 		}
 
 	case protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind, protoreflect.Fixed32Kind,
@@ -615,15 +1598,30 @@ func (fdInfo *fieldInfo) writeMarshal(t tab, gen *protogen.GeneratedFile, mi map
 		protoreflect.Sint32Kind, protoreflect.Sint64Kind, protoreflect.Uint32Kind,
 		protoreflect.Uint64Kind, protoreflect.DoubleKind, protoreflect.FloatKind:
 
-		t.P(gen, `if v, ok := obj["`, fdInfo.fieldKey, `"].(float64); ok {`)
-		t++
-		t.P(gen, mapIndex, ` = `, fieldType, `(v)`)
-		t--
-		t.P(gen, `}`)
+		fdInfo.writeNumberMarshal(t, gen, `obj["`+fdInfo.protoKey+`"]`, func(t tab, expr string) {
+			t.P(gen, mapIndex, ` = `, expr)
+		})
+
+	case protoreflect.EnumKind:
+
+		newEnumInfo(fdInfo.value.Enum).writeFromProto(t, gen, `obj["`+fdInfo.protoKey+`"]`, func(t tab, expr string) {
+			t.P(gen, mapIndex, ` = `, expr)
+		})
+
+	case protoreflect.BytesKind:
+
+		if fdInfo.isRawBytes() {
+			fdInfo.writeRawBytesMarshal(t, gen, `obj["`+fdInfo.protoKey+`"]`, func(t tab, expr string) {
+				t.P(gen, mapIndex, ` = `, expr)
+			})
+			break
+		}
+
+		t.P(gen, mapIndex, `, _ = obj["`, fdInfo.protoKey, `"].(`, fieldType, `)`)
 
-	case protoreflect.BoolKind, protoreflect.StringKind, protoreflect.EnumKind, protoreflect.BytesKind:
+	case protoreflect.BoolKind, protoreflect.StringKind:
 
-		t.P(gen, mapIndex, `, _ = obj["`, fdInfo.fieldKey, `"].(`, fieldType, `)`)
+		t.P(gen, mapIndex, `, _ = obj["`, fdInfo.protoKey, `"].(`, fieldType, `)`)
 
 	}
 
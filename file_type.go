@@ -1,6 +1,8 @@
 package main
 
 import (
+	"fmt"
+
 	terraformpb "github.com/protomesh/protoc-gen-terraform/proto/terraform"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/proto"
@@ -14,7 +16,11 @@ type fileInfo struct {
 	file   *protogen.File
 	schema *terraformpb.FileSchema
 
-	messages map[string]*protogen.Message
+	messages     map[string]*protogen.Message
+	messageOrder []string
+
+	// Previous definitions of the resources, by full name
+	previousMessages map[string]*protogen.Message
 }
 
 func newFileInfo(file *protogen.File) *fileInfo {
@@ -24,26 +30,192 @@ func newFileInfo(file *protogen.File) *fileInfo {
 		file:        file,
 		schema:      getFileSchema(file.Desc),
 		messages:    make(map[string]*protogen.Message),
+
+		previousMessages: make(map[string]*protogen.Message),
 	}
 
 	fInfo.importNeeds.customImportMap = fInfo.schema.ImportMap
+	fInfo.importNeeds.goImportPath = file.GoImportPath
 
 	return fInfo
 }
 
-func (fInfo *fileInfo) discoverMessage(msg *protogen.Message) {
+// discoverMessage adds the message, its nested messages and every message reachable
+// from its fields to the files generating them
+func (fInfo *fileInfo) discoverMessage(msg *protogen.Message, files map[string]*fileInfo) error {
+
+	fullName := string(msg.Desc.FullName())
+
+	if _, ok := fInfo.messages[fullName]; ok {
+		return nil
+	}
 
-	fInfo.messages[string(msg.Desc.FullName())] = msg
+	fInfo.messages[fullName] = msg
+	fInfo.messageOrder = append(fInfo.messageOrder, fullName)
 
 	fInfo.importNeeds.discoverMessage(msg)
 
+	if err := newMessageInfo(fInfo, msg).checkAttributeNames(); err != nil {
+		return err
+	}
+
 	for _, nested := range msg.Messages {
-		fInfo.discoverMessage(nested)
+
+		// Map entries are not blocks, their values are discovered from the map fields
+		if nested.Desc.IsMapEntry() {
+			continue
+		}
+
+		if err := fInfo.discoverMessage(nested, files); err != nil {
+			return err
+		}
 	}
 
+	// The schemas of the previous definitions are needed to upgrade the states
+	if msgSchema := getMessageSchema(msg.Desc); msgSchema.IsResource {
+
+		for _, upgrade := range msgSchema.StateUpgrades {
+
+			previous, owner := findMessage(files, upgrade.Message)
+			if previous == nil {
+				return fmt.Errorf(
+					"message %s is the version %d of %s but is not generated: it is not in the files to generate",
+					upgrade.Message, upgrade.Version, msg.Desc.FullName(),
+				)
+			}
+
+			if err := owner.discoverMessage(previous, files); err != nil {
+				return err
+			}
+
+			fInfo.previousMessages[upgrade.Message] = previous
+
+			// The resource references the schema function of the previous definition
+			if len(fInfo.importNeeds.getPackageForMessage(previous)) == 0 && previous.GoIdent.GoImportPath != fInfo.file.GoImportPath {
+				fInfo.importNeeds.getPackage(previous.GoIdent)
+			}
+
+		}
+
+	}
+
+	for _, field := range msg.Fields {
+
+		fdSchema := getFieldSchema(field.Desc)
+
+		// Only the top level attributes of the resources keep their configured values in the state
+		if fdSchema.WriteOnly && isOneofMember(field) && !newOneOfInfo(fInfo, field.Oneof).schema.Flatten {
+			return fmt.Errorf(
+				"field %s is write-only in the block of the oneof %s but only top level attributes keep their configured values, flatten the oneof",
+				field.Desc.FullName(), field.Oneof.Desc.Name(),
+			)
+		}
+
+		valueField := field
+
+		if field.Desc.IsMap() {
+			valueField = field.Message.Fields[1]
+		}
+
+		// The conversion tables of the enums are keyed by the terraform names
+		if valueField.Enum != nil && !fdSchema.Ignore {
+
+			if err := newEnumInfo(valueField.Enum).checkNames(); err != nil {
+				return fmt.Errorf("field %s: %v", field.Desc.FullName(), err)
+			}
+
+		}
+
+		fieldMsg := fieldMessage(field)
+
+		if fieldMsg == nil || isWellKnownMessage(fieldMsg) || fdSchema.Ignore {
+			continue
+		}
+
+		for _, nested := range fieldMsg.Fields {
+
+			nestedSchema := getFieldSchema(nested.Desc)
+
+			if nestedSchema.Ignore {
+				continue
+			}
+
+			if nestedSchema.WriteOnly {
+				return fmt.Errorf(
+					"field %s is write-only in the block of %s but only top level attributes keep their configured values",
+					nested.Desc.FullName(), field.Desc.FullName(),
+				)
+			}
+
+			// The state upgraders only move values to the top level attributes
+			if len(nestedSchema.PreviousName) > 0 {
+				return fmt.Errorf(
+					"field %s has a previous_name in the block of %s but state upgrades only move values to top level attributes",
+					nested.Desc.FullName(), field.Desc.FullName(),
+				)
+			}
+
+		}
+
+		// Messages mapped to other packages are generated elsewhere
+		if _, ok := fInfo.schema.ImportMap[string(fieldMsg.Desc.FullName())]; ok {
+			continue
+		}
+
+		owner, ok := files[fieldMsg.Desc.ParentFile().Path()]
+		if !ok {
+			return fmt.Errorf(
+				"message %s is referenced by %s but is not generated: %s is not in the files to generate, add it to the protoc invocation or to the import_map",
+				fieldMsg.Desc.FullName(), field.Desc.FullName(), fieldMsg.Desc.ParentFile().Path(),
+			)
+		}
+
+		if err := owner.discoverMessage(fieldMsg, files); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+
+}
+
+// findMessage returns the message with the full name and the file generating it
+func findMessage(files map[string]*fileInfo, fullName string) (*protogen.Message, *fileInfo) {
+
+	var find func(messages []*protogen.Message) *protogen.Message
+
+	find = func(messages []*protogen.Message) *protogen.Message {
+
+		for _, msg := range messages {
+
+			if string(msg.Desc.FullName()) == fullName {
+				return msg
+			}
+
+			if nested := find(msg.Messages); nested != nil {
+				return nested
+			}
+
+		}
+
+		return nil
+
+	}
+
+	for _, fInfo := range files {
+
+		if msg := find(fInfo.file.Messages); msg != nil {
+			return msg, fInfo
+		}
+
+	}
+
+	return nil, nil
+
 }
 
-func (fInfo *fileInfo) discoverFile() {
+func (fInfo *fileInfo) discoverFile(files map[string]*fileInfo) error {
 
 	if len(fInfo.file.Enums) > 0 {
 		fInfo.importNeeds.needValidation = true
@@ -55,22 +227,44 @@ func (fInfo *fileInfo) discoverFile() {
 
 		if msgOpts.Generate {
 
-			fInfo.discoverMessage(msg)
+			if err := fInfo.discoverMessage(msg, files); err != nil {
+				return err
+			}
 
 		}
 	}
 
+	return nil
+
 }
 
 func (fInfo *fileInfo) writeFunctions(t tab, gen *protogen.GeneratedFile) {
 
-	for _, msg := range fInfo.messages {
+	for _, fullName := range fInfo.messageOrder {
 
-		mInfo := newMessageInfo(fInfo, msg)
+		mInfo := newMessageInfo(fInfo, fInfo.messages[fullName])
 
 		mInfo.writeSchemaFunction(t, gen)
 		gen.P()
 
+		if mInfo.schema.IsResource {
+			mInfo.writeResourceFunction(t, gen)
+			gen.P()
+
+			mInfo.writeUpdateMaskFunction(t, gen)
+			gen.P()
+
+			if mInfo.hasID() {
+				mInfo.writeIDFunctions(t, gen)
+				gen.P()
+			}
+
+			if len(mInfo.schema.StateUpgrades) > 0 {
+				mInfo.writeStateUpgradeFunctions(t, gen)
+				gen.P()
+			}
+		}
+
 		mInfo.writeUnmarshaler(t, gen)
 		gen.P()
 
@@ -0,0 +1,667 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var update = flag.Bool("update", false, "rewrite the generated code of the test protos")
+
+const testModule = "github.com/protomesh/protoc-gen-terraform"
+
+// protocGenGo is the protoc-gen-go of the protobuf version in go.mod, built once for the tests
+var protocGenGo struct {
+	once sync.Once
+	dir  string
+	path string
+	err  error
+}
+
+func TestMain(m *testing.M) {
+
+	flag.Parse()
+
+	code := m.Run()
+
+	if protocGenGo.dir != "" {
+		os.RemoveAll(protocGenGo.dir)
+	}
+
+	os.Exit(code)
+
+}
+
+// runProtocGenGo runs protoc-gen-go as protoc does, it returns its generated files
+func runProtocGenGo(req *pluginpb.CodeGeneratorRequest) ([]*pluginpb.CodeGeneratorResponse_File, error) {
+
+	protocGenGo.once.Do(func() {
+
+		protocGenGo.dir, protocGenGo.err = os.MkdirTemp("", "protoc-gen-go")
+		if protocGenGo.err != nil {
+			return
+		}
+
+		protocGenGo.path = filepath.Join(protocGenGo.dir, "protoc-gen-go")
+
+		out, err := exec.Command("go", "build", "-o", protocGenGo.path, "google.golang.org/protobuf/cmd/protoc-gen-go").CombinedOutput()
+		if err != nil {
+			protocGenGo.err = fmt.Errorf("building protoc-gen-go: %v: %s", err, out)
+		}
+
+	})
+
+	if protocGenGo.err != nil {
+		return nil, protocGenGo.err
+	}
+
+	in, err := proto.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(protocGenGo.path)
+	cmd.Stdin = bytes.NewReader(in)
+
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("running protoc-gen-go: %v: %s", err, stderr)
+	}
+
+	resp := &pluginpb.CodeGeneratorResponse{}
+
+	if err := proto.Unmarshal(out, resp); err != nil {
+		return nil, err
+	}
+
+	if resp.Error != nil {
+		return nil, fmt.Errorf("protoc-gen-go: %s", resp.GetError())
+	}
+
+	return resp.File, nil
+
+}
+
+// compileProtos compiles the files and returns them with their imports, dependencies first.
+// Files are read from sources when set, from testdata/protos otherwise
+func compileProtos(t *testing.T, sources map[string]string, files ...string) []*descriptorpb.FileDescriptorProto {
+
+	t.Helper()
+
+	if sources != nil {
+
+		// The terraform annotations come from the repository
+		compiler := protocompile.Compiler{
+			Resolver: protocompile.WithStandardImports(protocompile.CompositeResolver{
+				&protocompile.SourceResolver{Accessor: protocompile.SourceAccessorFromMap(sources)},
+				&protocompile.SourceResolver{ImportPaths: []string{"."}},
+			}),
+			SourceInfoMode: protocompile.SourceInfoStandard,
+		}
+
+		return compileWith(t, compiler, files...)
+
+	}
+
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			ImportPaths: []string{"testdata/protos", "."},
+		}),
+		SourceInfoMode: protocompile.SourceInfoStandard,
+	}
+
+	return compileWith(t, compiler, files...)
+
+}
+
+func compileWith(t *testing.T, compiler protocompile.Compiler, files ...string) []*descriptorpb.FileDescriptorProto {
+
+	t.Helper()
+
+	result, err := compiler.Compile(context.Background(), files...)
+	if err != nil {
+		t.Fatalf("compiling %v: %v", files, err)
+	}
+
+	protoFiles := []*descriptorpb.FileDescriptorProto{}
+	seen := map[string]bool{}
+
+	var add func(fd protoreflect.FileDescriptor)
+
+	add = func(fd protoreflect.FileDescriptor) {
+
+		if seen[fd.Path()] {
+			return
+		}
+
+		seen[fd.Path()] = true
+
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+
+		// Options are sent serialized by protoc, the extensions are resolved
+		// with the registered annotations when unmarshaling them
+		b, err := proto.Marshal(protodesc.ToFileDescriptorProto(fd))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		fdProto := &descriptorpb.FileDescriptorProto{}
+
+		if err := proto.Unmarshal(b, fdProto); err != nil {
+			t.Fatal(err)
+		}
+
+		protoFiles = append(protoFiles, fdProto)
+
+	}
+
+	for _, fd := range result {
+		add(fd)
+	}
+
+	return protoFiles
+
+}
+
+// runPlugin runs protoc-gen-go and this plugin over the files, it returns the generated
+// files by name or the error of the generator
+func runPlugin(protoFiles []*descriptorpb.FileDescriptorProto, files ...string) (generated map[string]string, err error) {
+
+	// Generation errors are panics
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: files,
+		Parameter:      proto.String("module=" + testModule),
+		ProtoFile:      protoFiles,
+	}
+
+	plugin, err := protogen.Options{}.New(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := generate(plugin); err != nil {
+		return nil, err
+	}
+
+	resp := plugin.Response()
+
+	if resp.Error != nil {
+		return nil, fmt.Errorf("%s", resp.GetError())
+	}
+
+	goFiles, err := runProtocGenGo(req)
+	if err != nil {
+		return nil, err
+	}
+
+	generated = map[string]string{}
+
+	for _, f := range append(goFiles, resp.File...) {
+		generated[f.GetName()] = f.GetContent()
+	}
+
+	return generated, nil
+
+}
+
+// generateSource runs the plugin over a single proto source, it returns the terraform code
+func generateSource(t *testing.T, source string) (string, error) {
+
+	t.Helper()
+
+	protoFiles := compileProtos(t, map[string]string{"test.proto": source}, "test.proto")
+
+	generated, err := runPlugin(protoFiles, "test.proto")
+	if err != nil {
+		return "", err
+	}
+
+	for name, content := range generated {
+
+		if strings.HasSuffix(name, "_terraform.pb.go") {
+			return content, nil
+		}
+
+	}
+
+	return "", nil
+
+}
+
+// TestGeneratedCode checks the code generated from testdata/protos is the one in internal/e2e,
+// where it is built and exercised. Run with -update to regenerate it
+func TestGeneratedCode(t *testing.T) {
+
+	// The reduced googleapis protos are generated along the e2e protos importing them
+	files, err := filepath.Glob("testdata/protos/e2e/*.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	googleAPIFiles, err := filepath.Glob("testdata/protos/google/api/*.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files = append(files, googleAPIFiles...)
+
+	names := []string{}
+
+	for _, file := range files {
+		names = append(names, strings.TrimPrefix(filepath.ToSlash(file), "testdata/protos/"))
+	}
+
+	sort.Strings(names)
+
+	generated, err := runPlugin(compileProtos(t, nil, names...), names...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, content := range generated {
+
+		if *update {
+
+			if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := os.WriteFile(name, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			continue
+
+		}
+
+		current, err := os.ReadFile(name)
+		if err != nil {
+			t.Errorf("%s is not generated, run go test -run TestGeneratedCode -update: %v", name, err)
+			continue
+		}
+
+		if !bytes.Equal(current, []byte(content)) {
+			t.Errorf("%s is out of date, run go test -run TestGeneratedCode -update", name)
+		}
+
+	}
+
+}
+
+func TestUnsupportedWellKnownFields(t *testing.T) {
+
+	tests := map[string]struct {
+		field string
+		err   string
+	}{
+		"timestamp": {
+			field: "google.protobuf.Timestamp value = 1;",
+			err:   "message google.protobuf.Timestamp is referenced by test.Test.value but is not generated",
+		},
+		"repeated timestamp": {
+			field: "repeated google.protobuf.Timestamp value = 1;",
+			err:   "message google.protobuf.Timestamp is referenced by test.Test.value but is not generated",
+		},
+		"map timestamp": {
+			field: "map<string, google.protobuf.Timestamp> value = 1;",
+			err:   "message google.protobuf.Timestamp is referenced by test.Test.value but is not generated",
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+
+			_, err := generateSource(t, fmt.Sprintf(`syntax = "proto3";
+
+package test;
+
+import "google/protobuf/duration.proto";
+import "google/protobuf/timestamp.proto";
+import "terraform/annotations.proto";
+
+option go_package = "example.com/test;test";
+
+message Test {
+  option (protomesh.terraform.message_schema) = { generate: true };
+
+  %s
+}
+`, test.field))
+
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("got error %v, want %q", err, test.err)
+			}
+
+		})
+
+	}
+
+}
+
+func TestNestedStateSkippedFields(t *testing.T) {
+
+	tests := map[string]struct {
+		source string
+		err    string
+	}{
+		"write-only in nested block": {
+			source: `message Test {
+  option (protomesh.terraform.message_schema) = { generate: true, is_resource: true };
+
+  Login login = 1;
+}
+
+message Login {
+  string password = 1 [(protomesh.terraform.field_schema) = { sensitive: true, write_only: true }];
+}`,
+			err: "field test.Login.password is write-only in the block of test.Test.login but only top level attributes keep their configured values",
+		},
+		"write-only in set": {
+			source: `message Test {
+  option (protomesh.terraform.message_schema) = { generate: true, is_resource: true };
+
+  repeated Login logins = 1 [(protomesh.terraform.field_schema) = { is_type_set: true }];
+}
+
+message Login {
+  string token = 1 [(protomesh.terraform.field_schema) = { write_only: true }];
+}`,
+			err: "field test.Login.token is write-only in the block of test.Test.logins but only top level attributes keep their configured values",
+		},
+		"write-only in oneof block": {
+			source: `message Test {
+  option (protomesh.terraform.message_schema) = { generate: true, is_resource: true };
+
+  oneof auth {
+    string password = 1 [(protomesh.terraform.field_schema) = { write_only: true }];
+    string user = 2;
+  }
+}`,
+			err: "field test.Test.password is write-only in the block of the oneof auth but only top level attributes keep their configured values, flatten the oneof",
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+
+			_, err := generateSource(t, `syntax = "proto3";
+
+package test;
+
+import "terraform/annotations.proto";
+
+option go_package = "example.com/test;test";
+
+`+test.source)
+
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("got error %v, want %q", err, test.err)
+			}
+
+		})
+
+	}
+
+}
+
+func TestEnumNameCollisions(t *testing.T) {
+
+	tests := map[string]struct {
+		source string
+		err    string
+	}{
+		"aliases": {
+			source: `enum Color {
+  COLOR_UNSPECIFIED = 0;
+  COLOR_RED = 1 [(protomesh.terraform.enum_value_schema) = { alias: "red" }];
+  COLOR_CRIMSON = 2 [(protomesh.terraform.enum_value_schema) = { alias: "red" }];
+}
+
+message Test {
+  option (protomesh.terraform.message_schema) = { generate: true };
+
+  Color color = 1;
+}`,
+			err: "field test.Test.color: values COLOR_RED and COLOR_CRIMSON of the enum test.Color have the same terraform name red, set an alias on one of them",
+		},
+		"stripped prefixes": {
+			source: `message Test {
+  option (protomesh.terraform.message_schema) = { generate: true };
+
+  enum Color {
+    option (protomesh.terraform.enum_schema) = { strip_prefix: true };
+
+    COLOR_UNSPECIFIED = 0;
+    TEST_COLOR_RED = 1;
+    COLOR_RED = 2;
+  }
+
+  repeated Color colors = 1;
+}`,
+			err: "field test.Test.colors: values TEST_COLOR_RED and COLOR_RED of the enum test.Test.Color have the same terraform name RED, set an alias on one of them",
+		},
+		"lowercase map value": {
+			source: `enum Color {
+  option (protomesh.terraform.enum_schema) = { lowercase: true };
+
+  COLOR_UNSPECIFIED = 0;
+  RED = 1;
+  COLOR_CRIMSON = 2 [(protomesh.terraform.enum_value_schema) = { alias: "red" }];
+}
+
+message Test {
+  option (protomesh.terraform.message_schema) = { generate: true };
+
+  map<string, Color> colors = 1;
+}`,
+			err: "field test.Test.colors: values RED and COLOR_CRIMSON of the enum test.Color have the same terraform name red, set an alias on one of them",
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+
+			_, err := generateSource(t, `syntax = "proto3";
+
+package test;
+
+import "terraform/annotations.proto";
+
+option go_package = "example.com/test;test";
+
+`+test.source)
+
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("got error %v, want %q", err, test.err)
+			}
+
+		})
+
+	}
+
+}
+
+func TestDuplicateAttributeNames(t *testing.T) {
+
+	tests := map[string]struct {
+		source string
+		err    string
+	}{
+		"name override": {
+			source: `message Test {
+  option (protomesh.terraform.message_schema) = { generate: true };
+
+  string label = 1;
+  string display_name = 2 [(protomesh.terraform.field_schema) = { name: "label" }];
+}`,
+			err: `duplicate terraform attribute "label" in message test.Test (label and display_name)`,
+		},
+		"naming strategy": {
+			source: `option (protomesh.terraform.file_schema) = { naming_strategy: NAMING_STRATEGY_JSON_NAME_SNAKE_CASE };
+
+message Test {
+  option (protomesh.terraform.message_schema) = { generate: true };
+
+  string display_name = 1 [json_name = "label"];
+  string label = 2 [json_name = "text", (protomesh.terraform.field_schema) = { name: "label" }];
+}`,
+			err: `duplicate terraform attribute "label" in message test.Test (display_name and label)`,
+		},
+		"nested block": {
+			source: `message Test {
+  option (protomesh.terraform.message_schema) = { generate: true };
+
+  Nested nested = 1;
+}
+
+message Nested {
+  option (protomesh.terraform.message_schema) = {
+    virtual_attributes: { name: "size", type: ATTRIBUTE_TYPE_INT }
+  };
+
+  int32 size = 1;
+}`,
+			err: `duplicate terraform attribute "size" in message test.Nested (size and virtual attribute)`,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+
+			_, err := generateSource(t, `syntax = "proto3";
+
+package test;
+
+import "terraform/annotations.proto";
+
+option go_package = "example.com/test;test";
+
+`+test.source)
+
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("got error %v, want %q", err, test.err)
+			}
+
+		})
+
+	}
+
+}
+
+func TestInvalidItemBounds(t *testing.T) {
+
+	tests := map[string]struct {
+		field string
+		err   string
+	}{
+		"max_items on a scalar": {
+			field: `string name = 1 [(protomesh.terraform.field_schema) = { max_items: 2 }];`,
+			err:   "min_items and max_items of test.Test.name are only supported on repeated fields and maps of messages",
+		},
+		"min_items on a map of scalars": {
+			field: `map<string, string> labels = 1 [(protomesh.terraform.field_schema) = { min_items: 1 }];`,
+			err:   "min_items and max_items of test.Test.labels are only supported on repeated fields and maps of messages",
+		},
+		"min_items greater than max_items": {
+			field: `repeated string names = 1 [(protomesh.terraform.field_schema) = { min_items: 3, max_items: 2 }];`,
+			err:   "min_items of test.Test.names is greater than max_items",
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+
+			_, err := generateSource(t, fmt.Sprintf(`syntax = "proto3";
+
+package test;
+
+import "terraform/annotations.proto";
+
+option go_package = "example.com/test;test";
+
+message Test {
+  option (protomesh.terraform.message_schema) = { generate: true };
+
+  %s
+}
+`, test.field))
+
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("got error %v, want %q", err, test.err)
+			}
+
+		})
+
+	}
+
+}
+
+func TestNestedPreviousName(t *testing.T) {
+
+	_, err := generateSource(t, `syntax = "proto3";
+
+package test;
+
+import "terraform/annotations.proto";
+
+option go_package = "example.com/test;test";
+
+message Test {
+  option (protomesh.terraform.message_schema) = {
+    generate: true
+    is_resource: true
+    schema_version: 1
+    state_upgrades: { version: 0, message: "test.Test.V0" }
+  };
+
+  message V0 {
+    int32 size = 1;
+  }
+
+  Config config = 1;
+}
+
+message Config {
+  int32 size = 1 [(protomesh.terraform.field_schema) = { previous_name: "size" }];
+}
+`)
+
+	const want = "field test.Config.size has a previous_name in the block of test.Test.config but state upgrades only move values to top level attributes"
+
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"regexp"
 	"strings"
+	"unicode"
 
 	"github.com/iancoleman/strcase"
 	"google.golang.org/protobuf/compiler/protogen"
@@ -13,6 +14,10 @@ const (
 	wellKnownDuration = "google.protobuf.Duration"
 )
 
+// Deprecation message of the deprecated resources, attributes and enum values
+// without their own message, formatted with the kind of element
+const defaultDeprecationMessage = "This %s is deprecated and will be removed in a future version."
+
 func isWellKnownMessage(msg *protogen.Message) bool {
 
 	if msg == nil {
@@ -30,6 +35,87 @@ func isWellKnownMessage(msg *protogen.Message) bool {
 
 }
 
+// isOneofMember reports if the field belongs to a declared oneof, proto3 optional
+// fields are wrapped in synthetic oneofs but are mapped as regular fields
+func isOneofMember(field *protogen.Field) bool {
+	return field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
+}
+
+// realOneofs returns the declared oneofs of the message, without the synthetic ones
+func realOneofs(msg *protogen.Message) []*protogen.Oneof {
+
+	oneOfs := []*protogen.Oneof{}
+
+	for _, oneOf := range msg.Oneofs {
+
+		if !oneOf.Desc.IsSynthetic() {
+			oneOfs = append(oneOfs, oneOf)
+		}
+
+	}
+
+	return oneOfs
+
+}
+
+// fieldMessage returns the message of the field blocks, for maps it is the message of the values
+func fieldMessage(field *protogen.Field) *protogen.Message {
+
+	if field.Desc.IsMap() {
+		return field.Message.Fields[1].Message
+	}
+
+	return field.Message
+
+}
+
+// Messages reachable through the fields of a message, computed once per message. The
+// descriptors are the keys as the names can be defined differently by several invocations
+var reachableMessages = map[protoreflect.MessageDescriptor]map[protoreflect.FullName]bool{}
+
+// messageReaches reports if target is msg or can be reached through the fields of msg
+func messageReaches(msg *protogen.Message, target *protogen.Message) bool {
+
+	reachable, ok := reachableMessages[msg.Desc]
+
+	if !ok {
+
+		reachable = map[protoreflect.FullName]bool{}
+
+		var walk func(*protogen.Message)
+
+		walk = func(current *protogen.Message) {
+
+			if reachable[current.Desc.FullName()] {
+				return
+			}
+
+			reachable[current.Desc.FullName()] = true
+
+			for _, field := range current.Fields {
+
+				fieldMsg := fieldMessage(field)
+
+				if fieldMsg == nil || isWellKnownMessage(fieldMsg) || getFieldSchema(field.Desc).Ignore {
+					continue
+				}
+
+				walk(fieldMsg)
+
+			}
+
+		}
+
+		walk(msg)
+
+		reachableMessages[msg.Desc] = reachable
+
+	}
+
+	return reachable[target.Desc.FullName()]
+
+}
+
 func getDescriptorFullName(desc protoreflect.Descriptor, delimiter string) string {
 
 	parts := []string{}
@@ -57,6 +143,39 @@ func getDescriptorFullName(desc protoreflect.Descriptor, delimiter string) strin
 
 }
 
+// toSnakeCase keeps digits attached to the preceding word and acronyms together (ipv4CIDR -> ipv4_cidr)
+func toSnakeCase(s string) string {
+
+	runes := []rune(s)
+
+	var b strings.Builder
+
+	for i, r := range runes {
+
+		if !unicode.IsUpper(r) {
+			b.WriteRune(r)
+			continue
+		}
+
+		if i > 0 {
+
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteRune('_')
+			}
+
+		}
+
+		b.WriteRune(unicode.ToLower(r))
+
+	}
+
+	return b.String()
+
+}
+
 type tab int
 
 func (t tab) String() string {
@@ -96,3 +215,53 @@ func getDescriptorParentFile(desc protoreflect.Descriptor) protoreflect.FileDesc
 	return currentDesc.(protoreflect.FileDescriptor)
 
 }
+
+// messageHasNumbers reports if the protojson of the message, nested messages included, has
+// JSON numbers. 64-bit integers are strings, the other integers and the floats are numbers
+func messageHasNumbers(msg *protogen.Message) bool {
+
+	visited := map[protoreflect.FullName]bool{}
+
+	var walk func(*protogen.Message) bool
+
+	walk = func(current *protogen.Message) bool {
+
+		if visited[current.Desc.FullName()] {
+			return false
+		}
+
+		visited[current.Desc.FullName()] = true
+
+		for _, field := range current.Fields {
+
+			if getFieldSchema(field.Desc).Ignore {
+				continue
+			}
+
+			value := field.Desc
+
+			if value.IsMap() {
+				value = value.MapValue()
+			}
+
+			switch value.Kind() {
+
+			case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+				protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.FloatKind, protoreflect.DoubleKind:
+				return true
+
+			}
+
+			if fieldMsg := fieldMessage(field); fieldMsg != nil && !isWellKnownMessage(fieldMsg) && walk(fieldMsg) {
+				return true
+			}
+
+		}
+
+		return false
+
+	}
+
+	return walk(msg)
+
+}
@@ -3,16 +3,29 @@ package main
 import (
 	"fmt"
 	"path"
+	"sort"
 	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 type importNeeds struct {
+	needFmt        bool
+	needStrconv    bool
+	needRegexp     bool
+	needBase64     bool
+	needMath       bool
+	needFieldMask  bool
+	needDiag       bool
+	needContext    bool
 	needTime       bool
 	needSchema     bool
 	needValidation bool
 	needEncoding   bool
+	needBytes      bool
+
+	goImportPath protogen.GoImportPath
 
 	customImports   map[string]string
 	customImportMap map[string]string
@@ -22,10 +35,19 @@ type importNeeds struct {
 
 func newImportNeeds() *importNeeds {
 	return &importNeeds{
+		needFmt:           false,
+		needStrconv:       false,
+		needRegexp:        false,
+		needBase64:        false,
+		needMath:          false,
+		needFieldMask:     false,
+		needDiag:          false,
+		needContext:       false,
 		needTime:          false,
 		needSchema:        false,
 		needValidation:    false,
 		needEncoding:      false,
+		needBytes:         false,
 		customImports:     make(map[string]string),
 		customImportMap:   make(map[string]string),
 		usedCustomImports: make(map[string]string),
@@ -65,7 +87,7 @@ func (in *importNeeds) getPackage(i protogen.GoIdent) string {
 	ip := string(i.GoImportPath)
 
 	if p, ok := in.customImports[ip]; ok {
-		in.usedCustomImports[ip] = p
+		in.usedCustomImports[fmt.Sprintf(`"%s"`, ip)] = p
 		return fmt.Sprintf("%s.", p)
 	}
 
@@ -76,7 +98,7 @@ func (in *importNeeds) getPackage(i protogen.GoIdent) string {
 func (in *importNeeds) discoverFiles(files []*protogen.File) {
 
 	for _, f := range files {
-		in.customImports[f.GoImportPath.String()] = string(f.GoPackageName)
+		in.customImports[string(f.GoImportPath)] = string(f.GoPackageName)
 	}
 
 }
@@ -92,60 +114,252 @@ func (in *importNeeds) discoverMessage(msg *protogen.Message) {
 
 	in.getPackageForMessage(msg)
 
+	// The numbers of the protojson are decoded as json.Number
+	if messageHasNumbers(msg) {
+		in.needBytes = true
+	}
+
+	if msgSchema := getMessageSchema(msg.Desc); msgSchema.IsResource {
+
+		in.needFieldMask = true
+		in.needDiag = true
+
+		mInfo := newMessageInfo(nil, msg)
+
+		if len(mInfo.timeouts()) > 0 {
+			in.needTime = true
+		}
+
+		// State upgraders
+		if len(msgSchema.StateUpgrades) > 0 {
+			in.needContext = true
+		}
+
+		// ID functions and the importer
+		if mInfo.hasID() {
+			in.needContext = true
+			in.needFmt = true
+			in.needRegexp = true
+		}
+
+		if len(msgSchema.IdFields) > 0 {
+
+			for _, fdInfo := range mInfo.idFields() {
+
+				if fdInfo.getFieldGoType() == "int" {
+					in.needStrconv = true
+				}
+
+			}
+
+		}
+
+	}
+
+	for _, oneOf := range realOneofs(msg) {
+
+		if oInfo := newOneOfInfo(nil, oneOf); oInfo.schema.Required || !oInfo.isConstrained() {
+			in.needFmt = true
+		}
+
+	}
+
 	for _, field := range msg.Fields {
 
 		fdInfo := newFieldInfo(nil, field)
 
-		if field.Message != nil {
+		if fdInfo.schema.Ignore {
+			continue
+		}
+
+		if fieldMsg := fieldMessage(field); fieldMsg != nil {
+
+			if len(in.getPackageForMessage(fieldMsg)) == 0 && !isWellKnownMessage(fieldMsg) && fieldMsg.GoIdent.GoImportPath != in.goImportPath {
+				in.getPackage(fieldMsg.GoIdent)
+			}
+
+		}
 
-			in.getPackageForMessage(field.Message)
+		if fieldMsg := fieldMessage(field); fieldMsg != nil {
 
-			switch field.Message.Desc.FullName() {
+			switch fieldMsg.Desc.FullName() {
 
 			case wellKnownDuration:
-				if fdInfo.schema.DefaultValue != nil && fdInfo.schema.DefaultValue.AsInterface() != nil {
-					in.needTime = true
+				// Durations are validated, compared and converted with time.ParseDuration
+				in.needTime = true
+				in.needFmt = true
+				in.needStrconv = true
+
+				// Map values are validated by the map
+				if field.Desc.IsMap() {
+					in.needValidation = true
 				}
 
 			}
 
 		}
 
-		if field.Enum != nil || field.Desc.Enum() != nil {
+		if field.Enum != nil || field.Desc.Enum() != nil || fdInfo.isRecursive() {
 			in.needValidation = true
 		}
 
+		if len(fdInfo.schema.HashKeys) > 0 {
+			in.needFmt = true
+		}
+
+		valueInfo := fdInfo
+
+		if field.Desc.IsMap() {
+			valueInfo = fdInfo.mapValueInfo()
+		}
+
+		// 64-bit integers are parsed from the protojson strings or validated as strings
+		if valueInfo.is64Bit() {
+			in.needStrconv = true
+		}
+
+		// Unsigned values beyond the int64 range are reported
+		if kind := valueInfo.value.Desc.Kind(); !valueInfo.isInt64AsString() && (kind == protoreflect.Uint64Kind || kind == protoreflect.Fixed64Kind) {
+			in.needFmt = true
+		}
+
+		// Special float values are converted and float32 overflows reported
+		if valueInfo.isFloat() {
+			in.needMath = true
+			in.needStrconv = true
+		}
+
+		if valueInfo.value.Desc.Kind() == protoreflect.FloatKind {
+			in.needFmt = true
+		}
+
+		// Enum values missing from the conversion tables are reported
+		if valueInfo.value.Desc.Kind() == protoreflect.EnumKind {
+			in.needFmt = true
+		}
+
+		if valueInfo.value.Desc.Kind() == protoreflect.BytesKind {
+
+			if valueInfo.isRawBytes() {
+				in.needBase64 = true
+			} else {
+				in.needValidation = true
+			}
+
+		}
+
+		if valueInfo.isInt64AsString() {
+			in.needRegexp = true
+			in.needValidation = true
+		}
+
+		if field.Desc.IsMap() {
+
+			if field.Desc.MapValue().Enum() != nil {
+				in.needValidation = true
+			}
+
+			// Non-string keys are validated and parsed into the proto key kind
+			if field.Desc.MapKey().Kind() != protoreflect.StringKind {
+				in.needFmt = true
+				in.needStrconv = true
+				in.needRegexp = true
+				in.needValidation = true
+			}
+
+		}
+
 	}
 
 }
 
 func (in *importNeeds) writeFile(t tab, gen *protogen.GeneratedFile) {
 
-	t.P(gen, "import (")
+	// Import paths by package name, empty for the default name
+	imports := map[string]string{}
 
-	t++
+	if in.needContext {
+		imports["context"] = ""
+	}
+
+	if in.needFmt {
+		imports["fmt"] = ""
+	}
+
+	if in.needStrconv {
+		imports["strconv"] = ""
+	}
+
+	if in.needRegexp {
+		imports["regexp"] = ""
+	}
+
+	if in.needMath {
+		imports["math"] = ""
+	}
+
+	if in.needBase64 {
+		imports["encoding/base64"] = ""
+	}
 
 	if in.needTime {
-		t.P(gen, `"time"`)
+		imports["time"] = ""
 	}
 
 	if in.needSchema {
-		t.P(gen, `"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"`)
+		imports["github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"] = ""
 	}
 
 	if in.needValidation {
-		t.P(gen, `"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"`)
+		imports["github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"] = ""
+	}
+
+	if in.needDiag {
+		imports["github.com/hashicorp/go-cty/cty"] = ""
+		imports["github.com/hashicorp/terraform-plugin-sdk/v2/diag"] = ""
 	}
 
 	if in.needEncoding {
-		t.P(gen, `"google.golang.org/protobuf/encoding/protojson"`)
-		t.P(gen, `"google.golang.org/protobuf/proto"`)
-		t.P(gen, `"encoding/json"`)
-		t.P(gen, `"reflect"`)
+		imports["github.com/hashicorp/go-cty/cty"] = ""
+		imports["google.golang.org/protobuf/encoding/protojson"] = ""
+		imports["google.golang.org/protobuf/proto"] = ""
+		imports["encoding/json"] = ""
+		imports["reflect"] = ""
+	}
+
+	if in.needBytes {
+		imports["bytes"] = ""
+	}
+
+	if in.needFieldMask {
+		imports["google.golang.org/protobuf/types/known/fieldmaskpb"] = ""
 	}
 
 	for filePath, packageName := range in.usedCustomImports {
-		t.P(gen, fmt.Sprintf(`%s %s`, packageName, filePath))
+		imports[strings.Trim(filePath, `"`)] = packageName
+	}
+
+	// Sorted as gofmt does
+	paths := []string{}
+
+	for importPath := range imports {
+		paths = append(paths, importPath)
+	}
+
+	sort.Strings(paths)
+
+	t.P(gen, "import (")
+
+	t++
+
+	for _, importPath := range paths {
+
+		if packageName := imports[importPath]; len(packageName) > 0 {
+			t.P(gen, fmt.Sprintf(`%s "%s"`, packageName, importPath))
+		} else {
+			t.P(gen, fmt.Sprintf(`"%s"`, importPath))
+		}
+
 	}
 
 	t--
@@ -2,6 +2,7 @@ package main
 
 type selectorMaker interface {
 	makeSelector(string) string
+	getConfigVar() string
 }
 
 type mapIndexMaker interface {
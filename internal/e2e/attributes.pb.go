@@ -0,0 +1,248 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: e2e/attributes.proto
+
+package e2e
+
+import (
+	_ "github.com/protomesh/protoc-gen-terraform/proto/terraform"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Server bookkeeping left out of terraform and terraform only attributes
+type Bucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Etag      string           `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	Lifecycle *BucketLifecycle `protobuf:"bytes,3,opt,name=lifecycle,proto3" json:"lifecycle,omitempty"`
+}
+
+func (x *Bucket) Reset() {
+	*x = Bucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_e2e_attributes_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bucket) ProtoMessage() {}
+
+func (x *Bucket) ProtoReflect() protoreflect.Message {
+	mi := &file_e2e_attributes_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bucket.ProtoReflect.Descriptor instead.
+func (*Bucket) Descriptor() ([]byte, []int) {
+	return file_e2e_attributes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Bucket) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Bucket) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Bucket) GetLifecycle() *BucketLifecycle {
+	if x != nil {
+		return x.Lifecycle
+	}
+	return nil
+}
+
+type BucketLifecycle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Age        int32 `protobuf:"varint,1,opt,name=age,proto3" json:"age,omitempty"`
+	Generation int64 `protobuf:"varint,2,opt,name=generation,proto3" json:"generation,omitempty"`
+}
+
+func (x *BucketLifecycle) Reset() {
+	*x = BucketLifecycle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_e2e_attributes_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BucketLifecycle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BucketLifecycle) ProtoMessage() {}
+
+func (x *BucketLifecycle) ProtoReflect() protoreflect.Message {
+	mi := &file_e2e_attributes_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BucketLifecycle.ProtoReflect.Descriptor instead.
+func (*BucketLifecycle) Descriptor() ([]byte, []int) {
+	return file_e2e_attributes_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BucketLifecycle) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *BucketLifecycle) GetGeneration() int64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+var File_e2e_attributes_proto protoreflect.FileDescriptor
+
+var file_e2e_attributes_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x65, 0x32, 0x65, 0x2f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x65, 0x32, 0x65, 0x1a, 0x1b, 0x74, 0x65, 0x72,
+	0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x01, 0x0a, 0x06, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0xb9, 0x02, 0x02, 0x38, 0x01, 0x52, 0x04, 0x65,
+	0x74, 0x61, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x09, 0x6c, 0x69,
+	0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x3a, 0x4e, 0xba, 0xb9, 0x02, 0x4a, 0x08, 0x01, 0x10,
+	0x01, 0x22, 0x36, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x72,
+	0x6f, 0x79, 0x10, 0x04, 0x1a, 0x23, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x22, 0x0c, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x28, 0x01, 0x22, 0x4b, 0x0a, 0x0f, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0a,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x06, 0xba, 0xb9, 0x02, 0x02, 0x38, 0x01, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72,
+	0x6d, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x65, 0x32, 0x65, 0x3b, 0x65,
+	0x32, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_e2e_attributes_proto_rawDescOnce sync.Once
+	file_e2e_attributes_proto_rawDescData = file_e2e_attributes_proto_rawDesc
+)
+
+func file_e2e_attributes_proto_rawDescGZIP() []byte {
+	file_e2e_attributes_proto_rawDescOnce.Do(func() {
+		file_e2e_attributes_proto_rawDescData = protoimpl.X.CompressGZIP(file_e2e_attributes_proto_rawDescData)
+	})
+	return file_e2e_attributes_proto_rawDescData
+}
+
+var file_e2e_attributes_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_e2e_attributes_proto_goTypes = []any{
+	(*Bucket)(nil),          // 0: e2e.Bucket
+	(*BucketLifecycle)(nil), // 1: e2e.BucketLifecycle
+}
+var file_e2e_attributes_proto_depIdxs = []int32{
+	1, // 0: e2e.Bucket.lifecycle:type_name -> e2e.BucketLifecycle
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_e2e_attributes_proto_init() }
+func file_e2e_attributes_proto_init() {
+	if File_e2e_attributes_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_e2e_attributes_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Bucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_e2e_attributes_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*BucketLifecycle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_e2e_attributes_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_e2e_attributes_proto_goTypes,
+		DependencyIndexes: file_e2e_attributes_proto_depIdxs,
+		MessageInfos:      file_e2e_attributes_proto_msgTypes,
+	}.Build()
+	File_e2e_attributes_proto = out.File
+	file_e2e_attributes_proto_rawDesc = nil
+	file_e2e_attributes_proto_goTypes = nil
+	file_e2e_attributes_proto_depIdxs = nil
+}
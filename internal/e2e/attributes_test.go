@@ -0,0 +1,119 @@
+package e2e
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBucketIgnoredFields(t *testing.T) {
+
+	s := NewBucketSchema()
+
+	if _, ok := s["etag"]; ok {
+		t.Error("etag is in the schema")
+	}
+
+	if _, ok := NewBucketLifecycleSchema()["generation"]; ok {
+		t.Error("lifecycle.generation is in the schema")
+	}
+
+	p, err := MarshalBucketProto(&Bucket{
+		Name:      "logs",
+		Etag:      "abc",
+		Lifecycle: &BucketLifecycle{Age: 30, Generation: 7},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{
+		"name": "logs",
+		"lifecycle": []interface{}{
+			map[string]interface{}{"age": int64(30)},
+		},
+	}
+
+	if !reflect.DeepEqual(p, want) {
+		t.Errorf("marshaled %#v, want %#v", p, want)
+	}
+
+	// Ignored keys in the terraform values are not read
+	u, err := UnmarshalBucket(map[string]interface{}{
+		"name": "logs",
+		"etag": "abc",
+		"lifecycle": []interface{}{
+			map[string]interface{}{"age": 30, "generation": 7},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bucket := &Bucket{}
+
+	unmarshalProto(t, u, bucket)
+
+	assertProto(t, bucket, &Bucket{Name: "logs", Lifecycle: &BucketLifecycle{Age: 30}})
+
+	rd := plannedResourceData(t, NewBucketResource(), nil, map[string]interface{}{
+		"name": "logs",
+		"lifecycle": []interface{}{
+			map[string]interface{}{"age": 30},
+		},
+	})
+
+	assertPaths(t, BucketUpdateMask(rd), "name", "lifecycle")
+
+}
+
+func TestBucketVirtualAttributes(t *testing.T) {
+
+	s := NewBucketSchema()
+
+	if s["force_destroy"] == nil || !s["force_destroy"].Optional {
+		t.Errorf("force_destroy = %#v, want an optional attribute", s["force_destroy"])
+	}
+
+	if s["endpoint"] == nil || !s["endpoint"].Computed {
+		t.Errorf("endpoint = %#v, want a computed attribute", s["endpoint"])
+	}
+
+	config := map[string]interface{}{
+		"name":          "logs",
+		"force_destroy": true,
+	}
+
+	bucket := &Bucket{}
+
+	if err := unmarshalConfig(t, NewBucketResource(), config, UnmarshalBucketResourceData, bucket); err != nil {
+		t.Fatal(err)
+	}
+
+	assertProto(t, bucket, &Bucket{Name: "logs"})
+
+	p, err := UnmarshalBucket(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := p["force_destroy"]; ok {
+		t.Errorf("unmarshaled %v, want no force_destroy", p)
+	}
+
+	// Marshaling the proto keeps the configured virtual attributes
+	rd := configuredResourceData(t, NewBucketResource(), config)
+
+	if diags := MarshalBucketResourceData(&Bucket{Name: "logs"}, rd); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	assertState(t, rd, "force_destroy", true)
+
+	rd = plannedResourceData(t, NewBucketResource(), map[string]interface{}{"name": "logs"}, map[string]interface{}{
+		"name":          "archive",
+		"force_destroy": true,
+	})
+
+	assertPaths(t, BucketUpdateMask(rd), "name")
+
+}
@@ -0,0 +1,318 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: e2e/bounds.proto
+
+package e2e
+
+import (
+	_ "github.com/protomesh/protoc-gen-terraform/proto/terraform"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Collections with item bounds and a required block
+type Pool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Zones   []string               `protobuf:"bytes,1,rep,name=zones,proto3" json:"zones,omitempty"`
+	Members []*PoolMember          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	Backups map[string]*PoolMember `protobuf:"bytes,3,rep,name=backups,proto3" json:"backups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Health  *PoolHealth            `protobuf:"bytes,4,opt,name=health,proto3" json:"health,omitempty"`
+}
+
+func (x *Pool) Reset() {
+	*x = Pool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_e2e_bounds_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pool) ProtoMessage() {}
+
+func (x *Pool) ProtoReflect() protoreflect.Message {
+	mi := &file_e2e_bounds_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pool.ProtoReflect.Descriptor instead.
+func (*Pool) Descriptor() ([]byte, []int) {
+	return file_e2e_bounds_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Pool) GetZones() []string {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+func (x *Pool) GetMembers() []*PoolMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Pool) GetBackups() map[string]*PoolMember {
+	if x != nil {
+		return x.Backups
+	}
+	return nil
+}
+
+func (x *Pool) GetHealth() *PoolHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+type PoolMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *PoolMember) Reset() {
+	*x = PoolMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_e2e_bounds_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolMember) ProtoMessage() {}
+
+func (x *PoolMember) ProtoReflect() protoreflect.Message {
+	mi := &file_e2e_bounds_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolMember.ProtoReflect.Descriptor instead.
+func (*PoolMember) Descriptor() ([]byte, []int) {
+	return file_e2e_bounds_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PoolMember) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type PoolHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interval int32 `protobuf:"varint,1,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (x *PoolHealth) Reset() {
+	*x = PoolHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_e2e_bounds_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolHealth) ProtoMessage() {}
+
+func (x *PoolHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_e2e_bounds_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolHealth.ProtoReflect.Descriptor instead.
+func (*PoolHealth) Descriptor() ([]byte, []int) {
+	return file_e2e_bounds_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PoolHealth) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+var File_e2e_bounds_proto protoreflect.FileDescriptor
+
+var file_e2e_bounds_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x65, 0x32, 0x65, 0x2f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x03, 0x65, 0x32, 0x65, 0x1a, 0x1b, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f,
+	0x72, 0x6d, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9b, 0x02, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x1e, 0x0a,
+	0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xba, 0xb9,
+	0x02, 0x04, 0x50, 0x01, 0x58, 0x03, 0x52, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x31, 0x0a,
+	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x65, 0x32, 0x65, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x42,
+	0x06, 0xba, 0xb9, 0x02, 0x02, 0x58, 0x02, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x12, 0x38, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x2e, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x06, 0xba, 0xb9, 0x02, 0x02, 0x50,
+	0x01, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x65, 0x32, 0x65,
+	0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x42, 0x06, 0xba, 0xb9, 0x02,
+	0x02, 0x10, 0x01, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x1a, 0x4b, 0x0a, 0x0c, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x65,
+	0x32, 0x65, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x08, 0xba, 0xb9, 0x02, 0x04, 0x08, 0x01,
+	0x10, 0x01, 0x22, 0x26, 0x0a, 0x0a, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x28, 0x0a, 0x0a, 0x50, 0x6f,
+	0x6f, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72,
+	0x6d, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x65, 0x32, 0x65, 0x3b, 0x65,
+	0x32, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_e2e_bounds_proto_rawDescOnce sync.Once
+	file_e2e_bounds_proto_rawDescData = file_e2e_bounds_proto_rawDesc
+)
+
+func file_e2e_bounds_proto_rawDescGZIP() []byte {
+	file_e2e_bounds_proto_rawDescOnce.Do(func() {
+		file_e2e_bounds_proto_rawDescData = protoimpl.X.CompressGZIP(file_e2e_bounds_proto_rawDescData)
+	})
+	return file_e2e_bounds_proto_rawDescData
+}
+
+var file_e2e_bounds_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_e2e_bounds_proto_goTypes = []any{
+	(*Pool)(nil),       // 0: e2e.Pool
+	(*PoolMember)(nil), // 1: e2e.PoolMember
+	(*PoolHealth)(nil), // 2: e2e.PoolHealth
+	nil,                // 3: e2e.Pool.BackupsEntry
+}
+var file_e2e_bounds_proto_depIdxs = []int32{
+	1, // 0: e2e.Pool.members:type_name -> e2e.PoolMember
+	3, // 1: e2e.Pool.backups:type_name -> e2e.Pool.BackupsEntry
+	2, // 2: e2e.Pool.health:type_name -> e2e.PoolHealth
+	1, // 3: e2e.Pool.BackupsEntry.value:type_name -> e2e.PoolMember
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_e2e_bounds_proto_init() }
+func file_e2e_bounds_proto_init() {
+	if File_e2e_bounds_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_e2e_bounds_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Pool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_e2e_bounds_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*PoolMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_e2e_bounds_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*PoolHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_e2e_bounds_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_e2e_bounds_proto_goTypes,
+		DependencyIndexes: file_e2e_bounds_proto_depIdxs,
+		MessageInfos:      file_e2e_bounds_proto_msgTypes,
+	}.Build()
+	File_e2e_bounds_proto = out.File
+	file_e2e_bounds_proto_rawDesc = nil
+	file_e2e_bounds_proto_goTypes = nil
+	file_e2e_bounds_proto_depIdxs = nil
+}
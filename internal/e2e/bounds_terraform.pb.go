@@ -0,0 +1,441 @@
+// Code generated by protoc-gen-terraform. DO NOT EDIT.
+
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"reflect"
+)
+
+func NewPoolSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zones": {
+			Type:     schema.TypeList,
+			MinItems: 1,
+			MaxItems: 3,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"members": {
+			Type:     schema.TypeList,
+			MaxItems: 2,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: NewPoolMemberSchema(),
+			},
+		},
+		"backups": {
+			Type:     schema.TypeSet,
+			MinItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: func() map[string]*schema.Schema {
+					s := NewPoolMemberSchema()
+					s["key"] = &schema.Schema{
+						Type:     schema.TypeString,
+						Required: true,
+					}
+					return s
+				}(),
+			},
+		},
+		"health": {
+			Type:     schema.TypeList,
+			MinItems: 1,
+			MaxItems: 1,
+			Required: true,
+			Elem: &schema.Resource{
+				Schema: NewPoolHealthSchema(),
+			},
+		},
+	}
+}
+
+func NewPoolResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:      NewPoolSchema(),
+		Description: "Collections with item bounds and a required block",
+	}
+}
+
+func PoolUpdateMask(rd *schema.ResourceData) *fieldmaskpb.FieldMask {
+	paths := []string{}
+	if rd.HasChange("zones") {
+		paths = append(paths, "zones")
+	}
+	if rd.HasChange("members") {
+		paths = append(paths, "members")
+	}
+	if rd.HasChange("backups") {
+		paths = append(paths, "backups")
+	}
+	if rd.HasChange("health") {
+		if o, n := rd.GetChange("health"); len(o.([]interface{})) != len(n.([]interface{})) {
+			paths = append(paths, "health")
+		} else {
+			if rd.HasChange("health.0.interval") {
+				paths = append(paths, "health.interval")
+			}
+		}
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func UnmarshalPool(obj map[string]interface{}) (map[string]interface{}, error) {
+	return UnmarshalPoolWithConfig(obj, cty.NilVal)
+}
+
+func UnmarshalPoolWithConfig(obj map[string]interface{}, config cty.Value) (map[string]interface{}, error) {
+	p := map[string]interface{}{}
+	if valueZones, okZones := obj["zones"].([]interface{}); okZones && reflect.ValueOf(valueZones).IsValid() && !reflect.ValueOf(valueZones).IsZero() {
+		list := valueZones
+		r := []string{}
+		for _, val := range list {
+			r = append(r, val.(string))
+		}
+		p["zones"] = r
+	}
+	if valueMembers, okMembers := obj["members"].([]interface{}); okMembers && reflect.ValueOf(valueMembers).IsValid() && !reflect.ValueOf(valueMembers).IsZero() {
+		list := valueMembers
+		r := []map[string]interface{}{}
+		for i, val := range list {
+			itemConfig := config
+			if config.IsKnown() && !config.IsNull() {
+				itemConfig = cty.DynamicVal
+				if c := config.GetAttr("members"); c.IsKnown() && !c.IsNull() && c.LengthInt() > i {
+					itemConfig = c.Index(cty.NumberIntVal(int64(i)))
+				}
+			}
+			e, _ := val.(map[string]interface{})
+			m, err := UnmarshalPoolMemberWithConfig(e, itemConfig)
+			if err != nil {
+				return nil, err
+			}
+			r = append(r, m)
+		}
+		p["members"] = r
+	}
+	if valueBackups, okBackups := obj["backups"].(*schema.Set); okBackups && valueBackups.Len() > 0 {
+		m := map[string]interface{}{}
+		for _, val := range valueBackups.List() {
+			e := val.(map[string]interface{})
+			itemConfig := config
+			if config.IsKnown() && !config.IsNull() {
+				itemConfig = cty.DynamicVal
+			}
+			v, err := UnmarshalPoolMemberWithConfig(e, itemConfig)
+			if err != nil {
+				return nil, err
+			}
+			k := e["key"].(string)
+			m[k] = v
+		}
+		p["backups"] = m
+	}
+	if valueHealthCollection, okHealth := obj["health"].([]interface{}); okHealth && reflect.ValueOf(valueHealthCollection).IsValid() && !reflect.ValueOf(valueHealthCollection).IsZero() && len(valueHealthCollection) > 0 {
+		if valueHealth, okHealth := valueHealthCollection[0].(map[string]interface{}); okHealth {
+			msgConfig := config
+			if config.IsKnown() && !config.IsNull() {
+				msgConfig = cty.DynamicVal
+				if c := config.GetAttr("health"); c.IsKnown() && !c.IsNull() && c.LengthInt() > 0 {
+					msgConfig = c.Index(cty.NumberIntVal(int64(0)))
+				}
+			}
+			msg, err := UnmarshalPoolHealthWithConfig(valueHealth, msgConfig)
+			if err != nil {
+				return nil, err
+			}
+			p["health"] = msg
+		}
+	}
+	return p, nil
+}
+
+func UnmarshalPoolProto(obj map[string]interface{}, m proto.Message) error {
+	d, err := UnmarshalPool(obj)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	if err := protojson.Unmarshal(b, m); err != nil {
+		return err
+	}
+	return nil
+}
+
+func UnmarshalPoolResourceData(rd *schema.ResourceData) (map[string]interface{}, error) {
+	config := rd.GetRawConfig()
+	if config.IsNull() {
+		config = cty.DynamicVal
+	}
+	p := map[string]interface{}{}
+	if valueZones, okZones := rd.Get("zones").([]interface{}); okZones && reflect.ValueOf(valueZones).IsValid() && !reflect.ValueOf(valueZones).IsZero() {
+		list := valueZones
+		r := []string{}
+		for _, val := range list {
+			r = append(r, val.(string))
+		}
+		p["zones"] = r
+	}
+	if valueMembers, okMembers := rd.Get("members").([]interface{}); okMembers && reflect.ValueOf(valueMembers).IsValid() && !reflect.ValueOf(valueMembers).IsZero() {
+		list := valueMembers
+		r := []map[string]interface{}{}
+		for i, val := range list {
+			itemConfig := config
+			if config.IsKnown() && !config.IsNull() {
+				itemConfig = cty.DynamicVal
+				if c := config.GetAttr("members"); c.IsKnown() && !c.IsNull() && c.LengthInt() > i {
+					itemConfig = c.Index(cty.NumberIntVal(int64(i)))
+				}
+			}
+			e, _ := val.(map[string]interface{})
+			m, err := UnmarshalPoolMemberWithConfig(e, itemConfig)
+			if err != nil {
+				return nil, err
+			}
+			r = append(r, m)
+		}
+		p["members"] = r
+	}
+	if valueBackups, okBackups := rd.Get("backups").(*schema.Set); okBackups && valueBackups.Len() > 0 {
+		m := map[string]interface{}{}
+		for _, val := range valueBackups.List() {
+			e := val.(map[string]interface{})
+			itemConfig := config
+			if config.IsKnown() && !config.IsNull() {
+				itemConfig = cty.DynamicVal
+			}
+			v, err := UnmarshalPoolMemberWithConfig(e, itemConfig)
+			if err != nil {
+				return nil, err
+			}
+			k := e["key"].(string)
+			m[k] = v
+		}
+		p["backups"] = m
+	}
+	if valueHealthCollection, okHealth := rd.Get("health").([]interface{}); okHealth && reflect.ValueOf(valueHealthCollection).IsValid() && !reflect.ValueOf(valueHealthCollection).IsZero() && len(valueHealthCollection) > 0 {
+		if valueHealth, okHealth := valueHealthCollection[0].(map[string]interface{}); okHealth {
+			msgConfig := config
+			if config.IsKnown() && !config.IsNull() {
+				msgConfig = cty.DynamicVal
+				if c := config.GetAttr("health"); c.IsKnown() && !c.IsNull() && c.LengthInt() > 0 {
+					msgConfig = c.Index(cty.NumberIntVal(int64(0)))
+				}
+			}
+			msg, err := UnmarshalPoolHealthWithConfig(valueHealth, msgConfig)
+			if err != nil {
+				return nil, err
+			}
+			p["health"] = msg
+		}
+	}
+	return p, nil
+}
+
+func MarshalPool(obj map[string]interface{}) (map[string]interface{}, error) {
+	p := map[string]interface{}{}
+	if l, ok := obj["zones"].([]interface{}); ok {
+		p["zones"] = []interface{}{}
+		for _, i := range l {
+			p["zones"] = append(p["zones"].([]interface{}), i.(string))
+		}
+	}
+	if l, ok := obj["members"].([]interface{}); ok {
+		p["members"] = []interface{}{}
+		for _, i := range l {
+			d, err := MarshalPoolMember(i.(map[string]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			p["members"] = append(p["members"].([]interface{}), d)
+		}
+	}
+	if m, ok := obj["backups"].(map[string]interface{}); ok {
+		l := []interface{}{}
+		for k, v := range m {
+			d, err := MarshalPoolMember(v.(map[string]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			d["key"] = k
+			l = append(l, d)
+		}
+		p["backups"] = l
+	}
+	if m, ok := obj["health"].(map[string]interface{}); ok {
+		d, err := MarshalPoolHealth(m)
+		if err != nil {
+			return nil, err
+		}
+		p["health"] = []interface{}{d}
+	}
+	return p, nil
+}
+
+func MarshalPoolProto(m proto.Message) (map[string]interface{}, error) {
+	obj := map[string]interface{}{}
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	err = d.Decode(&obj)
+	if err != nil {
+		return nil, err
+	}
+	return MarshalPool(obj)
+}
+
+func MarshalPoolResourceData(m proto.Message, rd *schema.ResourceData) diag.Diagnostics {
+	pMap, err := MarshalPoolProto(m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var diags diag.Diagnostics
+	for _, k := range []string{"zones", "members", "backups", "health"} {
+		if err := rd.Set(k, pMap[k]); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Unable to set the attribute " + k,
+				Detail:        err.Error(),
+				AttributePath: cty.GetAttrPath(k),
+			})
+		}
+	}
+	return diags
+}
+
+func NewPoolMemberSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"address": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func UnmarshalPoolMember(obj map[string]interface{}) (map[string]interface{}, error) {
+	return UnmarshalPoolMemberWithConfig(obj, cty.NilVal)
+}
+
+func UnmarshalPoolMemberWithConfig(obj map[string]interface{}, config cty.Value) (map[string]interface{}, error) {
+	p := map[string]interface{}{}
+	if valueAddress, okAddress := obj["address"].(string); okAddress && reflect.ValueOf(valueAddress).IsValid() && !reflect.ValueOf(valueAddress).IsZero() {
+		p["address"] = valueAddress
+	}
+	return p, nil
+}
+
+func UnmarshalPoolMemberProto(obj map[string]interface{}, m proto.Message) error {
+	d, err := UnmarshalPoolMember(obj)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	if err := protojson.Unmarshal(b, m); err != nil {
+		return err
+	}
+	return nil
+}
+
+func MarshalPoolMember(obj map[string]interface{}) (map[string]interface{}, error) {
+	p := map[string]interface{}{}
+	p["address"], _ = obj["address"].(string)
+	return p, nil
+}
+
+func MarshalPoolMemberProto(m proto.Message) (map[string]interface{}, error) {
+	obj := map[string]interface{}{}
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(b, &obj)
+	if err != nil {
+		return nil, err
+	}
+	return MarshalPoolMember(obj)
+}
+
+func NewPoolHealthSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"interval": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+	}
+}
+
+func UnmarshalPoolHealth(obj map[string]interface{}) (map[string]interface{}, error) {
+	return UnmarshalPoolHealthWithConfig(obj, cty.NilVal)
+}
+
+func UnmarshalPoolHealthWithConfig(obj map[string]interface{}, config cty.Value) (map[string]interface{}, error) {
+	p := map[string]interface{}{}
+	if valueInterval, okInterval := obj["interval"].(int); okInterval && reflect.ValueOf(valueInterval).IsValid() && !reflect.ValueOf(valueInterval).IsZero() {
+		p["interval"] = valueInterval
+	}
+	return p, nil
+}
+
+func UnmarshalPoolHealthProto(obj map[string]interface{}, m proto.Message) error {
+	d, err := UnmarshalPoolHealth(obj)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	if err := protojson.Unmarshal(b, m); err != nil {
+		return err
+	}
+	return nil
+}
+
+func MarshalPoolHealth(obj map[string]interface{}) (map[string]interface{}, error) {
+	p := map[string]interface{}{}
+	switch s := obj["interval"].(type) {
+	case json.Number:
+		n, err := s.Int64()
+		if err != nil {
+			return nil, err
+		}
+		p["interval"] = n
+	case float64:
+		p["interval"] = int64(s)
+	}
+	return p, nil
+}
+
+func MarshalPoolHealthProto(m proto.Message) (map[string]interface{}, error) {
+	obj := map[string]interface{}{}
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	err = d.Decode(&obj)
+	if err != nil {
+		return nil, err
+	}
+	return MarshalPoolHealth(obj)
+}
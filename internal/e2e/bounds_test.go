@@ -0,0 +1,137 @@
+package e2e
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestPoolItemBounds(t *testing.T) {
+
+	health := []interface{}{
+		map[string]interface{}{"interval": 10},
+	}
+
+	backups := []interface{}{
+		map[string]interface{}{"key": "eu", "address": "10.0.1.1"},
+	}
+
+	tests := map[string]struct {
+		config map[string]interface{}
+		err    string
+	}{
+		"within bounds": {
+			config: map[string]interface{}{
+				"zones":   []interface{}{"a", "b", "c"},
+				"members": []interface{}{map[string]interface{}{"address": "10.0.0.1"}},
+				"backups": backups,
+				"health":  health,
+			},
+		},
+		"too many values": {
+			config: map[string]interface{}{
+				"zones":   []interface{}{"a", "b", "c", "d"},
+				"backups": backups,
+				"health":  health,
+			},
+			err: "Too many list items",
+		},
+		"too few values": {
+			config: map[string]interface{}{
+				"zones":   []interface{}{},
+				"backups": backups,
+				"health":  health,
+			},
+			err: "Not enough list items",
+		},
+		"too many blocks": {
+			config: map[string]interface{}{
+				"members": []interface{}{
+					map[string]interface{}{"address": "10.0.0.1"},
+					map[string]interface{}{"address": "10.0.0.2"},
+					map[string]interface{}{"address": "10.0.0.3"},
+				},
+				"backups": backups,
+				"health":  health,
+			},
+			err: "Too many list items",
+		},
+		"too few map entries": {
+			config: map[string]interface{}{
+				"backups": []interface{}{},
+				"health":  health,
+			},
+			err: "Not enough list items",
+		},
+		"missing required block": {
+			config: map[string]interface{}{
+				"backups": backups,
+			},
+			err: "Missing required argument",
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+
+			diags := NewPoolResource().Validate(terraform.NewResourceConfigRaw(test.config))
+
+			if len(test.err) == 0 {
+
+				if diags.HasError() {
+					t.Errorf("got diagnostics %v, want none", diags)
+				}
+
+				return
+
+			}
+
+			found := false
+
+			for _, d := range diags {
+				found = found || strings.Contains(d.Summary, test.err)
+			}
+
+			if !found {
+				t.Errorf("got diagnostics %v, want %q", diags, test.err)
+			}
+
+		})
+
+	}
+
+}
+
+func TestPoolRequiredBlock(t *testing.T) {
+
+	pool := &Pool{}
+
+	err := unmarshalConfig(t, NewPoolResource(), map[string]interface{}{
+		"zones": []interface{}{"a"},
+		"backups": []interface{}{
+			map[string]interface{}{"key": "eu", "address": "10.0.1.1"},
+		},
+		"health": []interface{}{
+			map[string]interface{}{"interval": 10},
+		},
+	}, UnmarshalPoolResourceData, pool)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertProto(t, pool, &Pool{
+		Zones:   []string{"a"},
+		Backups: map[string]*PoolMember{"eu": {Address: "10.0.1.1"}},
+		Health:  &PoolHealth{Interval: 10},
+	})
+
+	rd := marshalState(t, NewPoolResource(), pool, MarshalPoolResourceData)
+
+	assertState(t, rd, "zones", []interface{}{"a"})
+	assertState(t, rd, "health", []interface{}{
+		map[string]interface{}{"interval": 10},
+	})
+
+}
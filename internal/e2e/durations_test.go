@@ -0,0 +1,84 @@
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestScheduleDurations(t *testing.T) {
+
+	schedule := &Schedule{}
+
+	err := unmarshalConfig(t, NewScheduleResource(), map[string]interface{}{
+		"interval": "1m",
+		"backoffs": []interface{}{"500ms", "1h30m"},
+		"timeouts": map[string]interface{}{"create": "1m", "delete": "90s"},
+	}, UnmarshalScheduleResourceData, schedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertProto(t, schedule, &Schedule{
+		Interval: durationpb.New(time.Minute),
+		Backoffs: []*durationpb.Duration{durationpb.New(500 * time.Millisecond), durationpb.New(90 * time.Minute)},
+		Timeouts: map[string]*durationpb.Duration{"create": durationpb.New(time.Minute), "delete": durationpb.New(90 * time.Second)},
+	})
+
+	rd := marshalState(t, NewScheduleResource(), schedule, MarshalScheduleResourceData)
+
+	assertState(t, rd, "interval", "1m0s")
+	assertState(t, rd, "backoffs", []interface{}{"500ms", "1h30m0s"})
+	assertState(t, rd, "timeouts", map[string]interface{}{"create": "1m0s", "delete": "1m30s"})
+
+}
+
+func TestScheduleDurationValidation(t *testing.T) {
+
+	s := NewScheduleSchema()
+
+	assertValid(t, s["interval"], "1m", true)
+	assertValid(t, s["interval"], "1 minute", false)
+
+	assertValid(t, s["backoffs"].Elem.(*schema.Schema), "1m", true)
+	assertValid(t, s["backoffs"].Elem.(*schema.Schema), "soon", false)
+
+	assertValid(t, s["timeouts"], map[string]interface{}{"create": "1m"}, true)
+	assertValid(t, s["timeouts"], map[string]interface{}{"create": "1m", "delete": "later"}, false)
+
+	_, err := UnmarshalSchedule(map[string]interface{}{
+		"timeouts": map[string]interface{}{"create": "later"},
+	})
+
+	assertError(t, err, `time: invalid duration "later"`)
+
+}
+
+func TestScheduleDurationDiffSuppress(t *testing.T) {
+
+	s := NewScheduleSchema()
+
+	tests := []struct {
+		suppress func(k, oldValue, newValue string, d *schema.ResourceData) bool
+		key      string
+	}{
+		{s["interval"].DiffSuppressFunc, "interval"},
+		{s["backoffs"].Elem.(*schema.Schema).DiffSuppressFunc, "backoffs.0"},
+		{s["timeouts"].DiffSuppressFunc, "timeouts.create"},
+	}
+
+	for _, test := range tests {
+
+		if !test.suppress(test.key, "1m0s", "60s", nil) {
+			t.Errorf("%s: 1m0s and 60s should not differ", test.key)
+		}
+
+		if test.suppress(test.key, "1m0s", "61s", nil) {
+			t.Errorf("%s: 1m0s and 61s should differ", test.key)
+		}
+
+	}
+
+}
@@ -0,0 +1,184 @@
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// unmarshalConfig reads the raw configuration of the resource into the message
+func unmarshalConfig(t *testing.T, r *schema.Resource, raw map[string]interface{}, unmarshal func(*schema.ResourceData) (map[string]interface{}, error), m proto.Message) error {
+
+	t.Helper()
+
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatalf("invalid resource: %v", err)
+	}
+
+	p, err := unmarshal(schema.TestResourceDataRaw(t, r.Schema, raw))
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := protojson.Unmarshal(b, m); err != nil {
+		t.Fatalf("unmarshaling %s: %v", b, err)
+	}
+
+	return nil
+
+}
+
+// configuredResourceData returns the resource data of the raw configuration with the configuration
+// terraform sends when planning and applying, schema.TestResourceDataRaw does not set it
+func configuredResourceData(t *testing.T, r *schema.Resource, raw map[string]interface{}) *schema.ResourceData {
+
+	t.Helper()
+
+	rd := schema.TestResourceDataRaw(t, r.Schema, raw)
+	rd.SetId("test")
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := rd.State()
+
+	state.RawConfig, err = ctyjson.Unmarshal(b, r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatalf("converting the configuration %s: %v", b, err)
+	}
+
+	return r.Data(state)
+
+}
+
+// plannedResourceData returns the resource data the update of the resource from the prior
+// configuration to the new one receives, with the changes between them
+func plannedResourceData(t *testing.T, r *schema.Resource, prior, raw map[string]interface{}) *schema.ResourceData {
+
+	t.Helper()
+
+	state := schema.TestResourceDataRaw(t, r.Schema, prior)
+	state.SetId("test")
+
+	diff, err := r.Diff(context.Background(), state.State(), terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff == nil {
+		return r.Data(state.State())
+	}
+
+	var rd *schema.ResourceData
+
+	apply := func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+		rd = d
+		return nil
+	}
+
+	r.CreateContext, r.UpdateContext = apply, apply
+	r.DeleteContext = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics { return nil }
+
+	if _, diags := r.Apply(context.Background(), state.State(), diff, nil); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	return rd
+
+}
+
+// marshalState writes the message into the state of the resource
+func marshalState(t *testing.T, r *schema.Resource, m proto.Message, marshal func(proto.Message, *schema.ResourceData) diag.Diagnostics) *schema.ResourceData {
+
+	t.Helper()
+
+	rd := r.TestResourceData()
+
+	if diags := marshal(m, rd); diags.HasError() {
+		t.Fatalf("marshaling %v: %v", protojson.Format(m), diags)
+	}
+
+	return rd
+
+}
+
+func assertState(t *testing.T, rd *schema.ResourceData, key string, want interface{}) {
+
+	t.Helper()
+
+	if got := rd.Get(key); !reflect.DeepEqual(got, want) {
+		t.Errorf("%s = %#v, want %#v", key, got, want)
+	}
+
+}
+
+// assertValid checks the value against the validation of the attribute
+func assertValid(t *testing.T, s *schema.Schema, value interface{}, valid bool) {
+
+	t.Helper()
+
+	if s.ValidateDiagFunc != nil {
+
+		if diags := s.ValidateDiagFunc(value, cty.GetAttrPath("attr")); !diags.HasError() != valid {
+			t.Errorf("validating %#v: got diagnostics %v, want valid %v", value, diags, valid)
+		}
+
+		return
+
+	}
+
+	_, errs := s.ValidateFunc(value, "attr")
+
+	if (len(errs) == 0) != valid {
+		t.Errorf("validating %#v: got errors %v, want valid %v", value, errs, valid)
+	}
+
+}
+
+func assertProto(t *testing.T, got, want proto.Message) {
+
+	t.Helper()
+
+	if !proto.Equal(got, want) {
+		t.Errorf("got %v, want %v", protojson.Format(got), protojson.Format(want))
+	}
+
+}
+
+func assertError(t *testing.T, err error, want string) {
+
+	t.Helper()
+
+	if err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+
+}
+
+// assertPaths checks the paths of the update mask
+func assertPaths(t *testing.T, mask *fieldmaskpb.FieldMask, want ...string) {
+
+	t.Helper()
+
+	if got := mask.GetPaths(); !reflect.DeepEqual(got, want) && (len(got) > 0 || len(want) > 0) {
+		t.Errorf("paths = %q, want %q", got, want)
+	}
+
+}
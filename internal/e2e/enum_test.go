@@ -0,0 +1,107 @@
+package e2e
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestPlanEnums(t *testing.T) {
+
+	plan := &Plan{}
+
+	err := unmarshalConfig(t, NewPlanResource(), map[string]interface{}{
+		"tier":     "premium",
+		"priority": 20,
+		"upgrades": []interface{}{"basic", "premium"},
+	}, UnmarshalPlanResourceData, plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertProto(t, plan, &Plan{
+		Tier:     Tier_TIER_PREMIUM,
+		Priority: Priority_PRIORITY_HIGH,
+		Upgrades: []Tier{Tier_TIER_BASIC, Tier_TIER_PREMIUM},
+	})
+
+	rd := marshalState(t, NewPlanResource(), plan, MarshalPlanResourceData)
+
+	assertState(t, rd, "tier", "premium")
+	assertState(t, rd, "priority", 20)
+	assertState(t, rd, "upgrades", []interface{}{"basic", "premium"})
+
+}
+
+func TestPlanEnumValidation(t *testing.T) {
+
+	s := NewPlanSchema()
+
+	assertValid(t, s["tier"], "basic", true)
+	assertValid(t, s["tier"], "unspecified", false)
+	assertValid(t, s["tier"], "TIER_BASIC", false)
+	assertValid(t, s["priority"], 10, true)
+	assertValid(t, s["priority"], 1, false)
+	assertValid(t, s["upgrades"].Elem.(*schema.Schema), "premium", true)
+
+}
+
+func TestPlanUnknownEnumValues(t *testing.T) {
+
+	_, err := UnmarshalPlan(map[string]interface{}{"tier": "gold"})
+
+	assertError(t, err, `"gold" is not a value of the enum e2e.Tier`)
+
+	// protojson writes the numbers without a name
+	_, err = MarshalPlanProto(&Plan{Tier: Tier(7)})
+
+	assertError(t, err, "7 is not a value of the enum e2e.Tier")
+
+	_, err = MarshalPlanProto(&Plan{Upgrades: []Tier{Tier_TIER_BASIC, Tier(7)}})
+
+	assertError(t, err, "7 is not a value of the enum e2e.Tier")
+
+}
+
+func TestPlanEnumValueAnnotations(t *testing.T) {
+
+	plan := &Plan{}
+
+	err := unmarshalConfig(t, NewPlanResource(), map[string]interface{}{
+		"region": "europe",
+	}, UnmarshalPlanResourceData, plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertProto(t, plan, &Plan{Region: Region_REGION_EU})
+
+	rd := marshalState(t, NewPlanResource(), plan, MarshalPlanResourceData)
+
+	assertState(t, rd, "region", "europe")
+
+	s := NewPlanSchema()
+
+	assertValid(t, s["region"], "REGION_EU", false)
+	assertValid(t, s["region"], "REGION_INTERNAL", false)
+	assertValid(t, s["region"], "REGION_US", true)
+
+}
+
+func TestDeprecationMessages(t *testing.T) {
+
+	const want = "Possible values: `REGION_UNSPECIFIED`; `europe`; `REGION_US` (deprecated: This value is deprecated and will be removed in a future version)."
+
+	if got := NewPlanSchema()["region"].Description; got != want {
+		t.Errorf("region description = %q, want %q", got, want)
+	}
+
+	if got := NewPlanSchema()["legacy"].Deprecated; got != "This attribute is deprecated and will be removed in a future version." {
+		t.Errorf("legacy deprecation = %q", got)
+	}
+
+	if got := NewOldPlanResource().DeprecationMessage; got != "This resource is deprecated and will be removed in a future version." {
+		t.Errorf("resource deprecation = %q", got)
+	}
+
+}
@@ -0,0 +1,75 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAttemptID(t *testing.T) {
+
+	r := NewAttemptResource()
+	rd := r.TestResourceData()
+
+	if err := AttemptSetID(rd, &Attempt{Widget: "w1", Attempt: 9007199254740993}); err != nil {
+		t.Fatal(err)
+	}
+
+	if rd.Id() != "widgets/w1/attempts/9007199254740993" {
+		t.Errorf("id = %q", rd.Id())
+	}
+
+	imported, err := r.Importer.StateContext(context.Background(), rd, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertState(t, imported[0], "widget", "w1")
+	assertState(t, imported[0], "attempt", 9007199254740993)
+
+	if s := NewAttemptSchema(); !s["widget"].ForceNew || !s["attempt"].ForceNew || s["status"].ForceNew {
+		t.Error("changing the id fields should replace the resource")
+	}
+
+}
+
+func TestAttemptInvalidID(t *testing.T) {
+
+	tests := map[string]struct {
+		attempt *Attempt
+		err     string
+	}{
+		"empty": {
+			attempt: &Attempt{},
+			err:     "widget of the id is not set",
+		},
+		"missing segment": {
+			attempt: &Attempt{Widget: "w1"},
+			err:     "attempt of the id is not set",
+		},
+		"slash": {
+			attempt: &Attempt{Widget: "a/b", Attempt: 1},
+			err:     `invalid id "widgets/a/b/attempts/1", expected widgets/{widget}/attempts/{attempt}`,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+
+			rd := NewAttemptResource().TestResourceData()
+
+			assertError(t, AttemptSetID(rd, test.attempt), test.err)
+
+			if rd.Id() != "" {
+				t.Errorf("id = %q, want no id", rd.Id())
+			}
+
+		})
+
+	}
+
+	_, err := AttemptParseID("widgets/w1/attempts/first")
+
+	assertError(t, err, `invalid attempt "first" in id: strconv.Atoi: parsing "first": invalid syntax`)
+
+}
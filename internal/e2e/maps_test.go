@@ -0,0 +1,188 @@
+package e2e
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestInventoryMaps(t *testing.T) {
+
+	inventory := &Inventory{}
+
+	err := unmarshalConfig(t, NewInventoryResource(), map[string]interface{}{
+		"labels": map[string]interface{}{"env": "prod"},
+		"ports":  map[string]interface{}{"-1": "any", "443": "https"},
+		"quotas": map[string]interface{}{"18446744073709551615": 3},
+		"flags":  map[string]interface{}{"true": "on"},
+		"shelves": []interface{}{
+			map[string]interface{}{"slot": "a1", "label": "top", "capacity": 4},
+		},
+	}, UnmarshalInventoryResourceData, inventory)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Inventory{
+		Labels:  map[string]string{"env": "prod"},
+		Ports:   map[int32]string{-1: "any", 443: "https"},
+		Quotas:  map[uint64]int64{18446744073709551615: 3},
+		Flags:   map[bool]string{true: "on"},
+		Shelves: map[string]*Shelf{"a1": {Label: "top", Capacity: 4}},
+	}
+
+	assertProto(t, inventory, want)
+
+	rd := marshalState(t, NewInventoryResource(), inventory, MarshalInventoryResourceData)
+
+	assertState(t, rd, "ports", map[string]interface{}{"-1": "any", "443": "https"})
+	assertState(t, rd, "quotas", map[string]interface{}{"18446744073709551615": 3})
+	assertState(t, rd, "flags", map[string]interface{}{"true": "on"})
+
+	shelves := rd.Get("shelves").(*schema.Set).List()
+
+	if len(shelves) != 1 || shelves[0].(map[string]interface{})["slot"] != "a1" {
+		t.Errorf("shelves = %v", shelves)
+	}
+
+}
+
+func TestInventoryMapKeys(t *testing.T) {
+
+	s := NewInventorySchema()
+
+	tests := []struct {
+		attr  string
+		key   string
+		valid bool
+	}{
+		{"ports", "-80", true},
+		{"ports", "http", false},
+		{"quotas", "10", true},
+		{"quotas", "-1", false},
+		{"flags", "false", true},
+		{"flags", "yes", false},
+	}
+
+	for _, test := range tests {
+
+		diags := s[test.attr].ValidateDiagFunc(map[string]interface{}{test.key: "v"}, cty.GetAttrPath(test.attr))
+
+		if !diags.HasError() != test.valid {
+			t.Errorf("validating key %q of %s: got %v, want valid %v", test.key, test.attr, diags, test.valid)
+		}
+
+	}
+
+	// Out of range keys pass the pattern and fail to parse
+	_, err := UnmarshalInventory(map[string]interface{}{
+		"ports": map[string]interface{}{"4294967296": "x"},
+	})
+
+	assertError(t, err, `invalid key "4294967296" of ports: strconv.ParseInt: parsing "4294967296": value out of range`)
+
+}
+
+func TestInventoryHashKeys(t *testing.T) {
+
+	racks := NewInventorySchema()["racks"]
+
+	set := schema.NewSet(racks.Set, []interface{}{
+		map[string]interface{}{"label": "a", "capacity": 1},
+		map[string]interface{}{"label": "a", "capacity": 2},
+		map[string]interface{}{"label": "b", "capacity": 1},
+	})
+
+	// Elements with the same key attributes are the same element
+	if set.Len() != 2 {
+		t.Errorf("got %d racks, want 2", set.Len())
+	}
+
+	inventory := &Inventory{}
+
+	err := unmarshalConfig(t, NewInventoryResource(), map[string]interface{}{
+		"racks": []interface{}{
+			map[string]interface{}{"label": "a", "capacity": 1},
+		},
+	}, UnmarshalInventoryResourceData, inventory)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertProto(t, inventory, &Inventory{Racks: []*Shelf{{Label: "a", Capacity: 1}}})
+
+}
+
+func TestInventoryMapValueValidation(t *testing.T) {
+
+	tests := map[string]struct {
+		config map[string]interface{}
+		err    string
+	}{
+		"valid values": {
+			config: map[string]interface{}{
+				"tiers":      map[string]interface{}{"web": "premium"},
+				"priorities": map[string]interface{}{"web": 10},
+				"checksums":  map[string]interface{}{"web": "aGVsbG8="},
+			},
+		},
+		"enum": {
+			config: map[string]interface{}{"tiers": map[string]interface{}{"web": "gold"}},
+			err:    `expected tiers.web to be one of ["basic" "premium"], got gold`,
+		},
+		"integer enum": {
+			config: map[string]interface{}{"priorities": map[string]interface{}{"web": 15}},
+			err:    "expected priorities.web to be one of [0 10 20], got 15",
+		},
+		"base64": {
+			config: map[string]interface{}{"checksums": map[string]interface{}{"web": "not base64"}},
+			err:    `expected "checksums.web" to be a base64 string, got not base64`,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+
+			diags := NewInventoryResource().Validate(terraform.NewResourceConfigRaw(test.config))
+
+			if len(test.err) == 0 {
+
+				if diags.HasError() {
+					t.Errorf("got diagnostics %v, want none", diags)
+				}
+
+				return
+
+			}
+
+			if len(diags) != 1 || diags[0].Summary != test.err {
+				t.Errorf("got diagnostics %v, want %q", diags, test.err)
+			}
+
+		})
+
+	}
+
+	inventory := &Inventory{}
+
+	err := unmarshalConfig(t, NewInventoryResource(), tests["valid values"].config, UnmarshalInventoryResourceData, inventory)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertProto(t, inventory, &Inventory{
+		Tiers:      map[string]Tier{"web": Tier_TIER_PREMIUM},
+		Priorities: map[string]Priority{"web": Priority_PRIORITY_LOW},
+		Checksums:  map[string][]byte{"web": []byte("hello")},
+	})
+
+	rd := marshalState(t, NewInventoryResource(), inventory, MarshalInventoryResourceData)
+
+	assertState(t, rd, "tiers", map[string]interface{}{"web": "premium"})
+	assertState(t, rd, "priorities", map[string]interface{}{"web": 10})
+	assertState(t, rd, "checksums", map[string]interface{}{"web": "aGVsbG8="})
+
+}
@@ -0,0 +1,58 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWidgetName(t *testing.T) {
+
+	r := NewWidgetResource()
+	rd := r.TestResourceData()
+
+	if err := WidgetSetID(rd, &Widget{Name: "projects/p1/locations/eu/widgets/w1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if rd.Id() != "projects/p1/locations/eu/widgets/w1" {
+		t.Errorf("id = %q", rd.Id())
+	}
+
+	assertState(t, rd, "project", "p1")
+	assertState(t, rd, "location", "eu")
+	assertState(t, rd, "widget", "w1")
+
+	// The segments of the previous pattern do not remain
+	if err := WidgetSetID(rd, &Widget{Name: "projects/p1/widgets/w2"}); err != nil {
+		t.Fatal(err)
+	}
+
+	assertState(t, rd, "location", "")
+	assertState(t, rd, "widget", "w2")
+
+}
+
+func TestWidgetImport(t *testing.T) {
+
+	r := NewWidgetResource()
+	rd := r.TestResourceData()
+	rd.SetId("projects/p1/widgets/w1")
+
+	imported, err := r.Importer.StateContext(context.Background(), rd, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertState(t, imported[0], "name", "projects/p1/widgets/w1")
+	assertState(t, imported[0], "project", "p1")
+	assertState(t, imported[0], "widget", "w1")
+
+	rd.SetId("projects/p1/gadgets/g1")
+
+	_, err = r.Importer.StateContext(context.Background(), rd, nil)
+
+	assertError(t, err, `invalid id "projects/p1/gadgets/g1", expected projects/{project}/widgets/{widget} or projects/{project}/locations/{location}/widgets/{widget}`)
+
+	assertError(t, WidgetSetID(r.TestResourceData(), &Widget{}), `invalid id "", expected projects/{project}/widgets/{widget} or projects/{project}/locations/{location}/widgets/{widget}`)
+
+}
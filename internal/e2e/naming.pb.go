@@ -0,0 +1,245 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: e2e/naming.proto
+
+package e2e
+
+import (
+	_ "github.com/protomesh/protoc-gen-terraform/proto/terraform"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Attributes named after the json names of the fields
+type Subnet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ipv4CIDR       string   `protobuf:"bytes,1,opt,name=ipv4CIDR,proto3" json:"ipv4CIDR,omitempty"`
+	DisplayName    string   `protobuf:"bytes,2,opt,name=display_name,json=label,proto3" json:"display_name,omitempty"`
+	MaxHosts       int32    `protobuf:"varint,3,opt,name=max_hosts,json=maxHosts,proto3" json:"max_hosts,omitempty"`
+	DefaultGateway *Gateway `protobuf:"bytes,4,opt,name=default_gateway,json=defaultGateway,proto3" json:"default_gateway,omitempty"`
+}
+
+func (x *Subnet) Reset() {
+	*x = Subnet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_e2e_naming_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Subnet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subnet) ProtoMessage() {}
+
+func (x *Subnet) ProtoReflect() protoreflect.Message {
+	mi := &file_e2e_naming_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subnet.ProtoReflect.Descriptor instead.
+func (*Subnet) Descriptor() ([]byte, []int) {
+	return file_e2e_naming_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Subnet) GetIpv4CIDR() string {
+	if x != nil {
+		return x.Ipv4CIDR
+	}
+	return ""
+}
+
+func (x *Subnet) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Subnet) GetMaxHosts() int32 {
+	if x != nil {
+		return x.MaxHosts
+	}
+	return 0
+}
+
+func (x *Subnet) GetDefaultGateway() *Gateway {
+	if x != nil {
+		return x.DefaultGateway
+	}
+	return nil
+}
+
+type Gateway struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextHop string `protobuf:"bytes,1,opt,name=nextHop,proto3" json:"nextHop,omitempty"`
+}
+
+func (x *Gateway) Reset() {
+	*x = Gateway{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_e2e_naming_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gateway) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway) ProtoMessage() {}
+
+func (x *Gateway) ProtoReflect() protoreflect.Message {
+	mi := &file_e2e_naming_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway.ProtoReflect.Descriptor instead.
+func (*Gateway) Descriptor() ([]byte, []int) {
+	return file_e2e_naming_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Gateway) GetNextHop() string {
+	if x != nil {
+		return x.NextHop
+	}
+	return ""
+}
+
+var File_e2e_naming_proto protoreflect.FileDescriptor
+
+var file_e2e_naming_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x65, 0x32, 0x65, 0x2f, 0x6e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x03, 0x65, 0x32, 0x65, 0x1a, 0x1b, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f,
+	0x72, 0x6d, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x01, 0x0a, 0x06, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x70, 0x76, 0x34, 0x43, 0x49, 0x44, 0x52, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x69, 0x70, 0x76, 0x34, 0x43, 0x49, 0x44, 0x52, 0x12, 0x1b, 0x0a, 0x0c, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x2b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
+	0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0e, 0xba, 0xb9, 0x02,
+	0x0a, 0x32, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x08, 0x6d, 0x61, 0x78,
+	0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x65, 0x32, 0x65, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x0e, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x3a, 0x08, 0xba, 0xb9,
+	0x02, 0x04, 0x08, 0x01, 0x10, 0x01, 0x22, 0x23, 0x0a, 0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x42, 0x42, 0xba, 0xb9, 0x02,
+	0x02, 0x10, 0x02, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x65, 0x32, 0x65, 0x3b, 0x65, 0x32, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_e2e_naming_proto_rawDescOnce sync.Once
+	file_e2e_naming_proto_rawDescData = file_e2e_naming_proto_rawDesc
+)
+
+func file_e2e_naming_proto_rawDescGZIP() []byte {
+	file_e2e_naming_proto_rawDescOnce.Do(func() {
+		file_e2e_naming_proto_rawDescData = protoimpl.X.CompressGZIP(file_e2e_naming_proto_rawDescData)
+	})
+	return file_e2e_naming_proto_rawDescData
+}
+
+var file_e2e_naming_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_e2e_naming_proto_goTypes = []any{
+	(*Subnet)(nil),  // 0: e2e.Subnet
+	(*Gateway)(nil), // 1: e2e.Gateway
+}
+var file_e2e_naming_proto_depIdxs = []int32{
+	1, // 0: e2e.Subnet.default_gateway:type_name -> e2e.Gateway
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_e2e_naming_proto_init() }
+func file_e2e_naming_proto_init() {
+	if File_e2e_naming_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_e2e_naming_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Subnet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_e2e_naming_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Gateway); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_e2e_naming_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_e2e_naming_proto_goTypes,
+		DependencyIndexes: file_e2e_naming_proto_depIdxs,
+		MessageInfos:      file_e2e_naming_proto_msgTypes,
+	}.Build()
+	File_e2e_naming_proto = out.File
+	file_e2e_naming_proto_rawDesc = nil
+	file_e2e_naming_proto_goTypes = nil
+	file_e2e_naming_proto_depIdxs = nil
+}
@@ -0,0 +1,82 @@
+package e2e
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSubnetNaming(t *testing.T) {
+
+	config := map[string]interface{}{
+		"ipv4_cidr": "10.0.0.0/16",
+		"label":     "private",
+		"capacity":  254,
+		"default_gateway": []interface{}{
+			map[string]interface{}{"next_hop": "10.0.0.1"},
+		},
+	}
+
+	subnet := &Subnet{}
+
+	if err := unmarshalConfig(t, NewSubnetResource(), config, UnmarshalSubnetResourceData, subnet); err != nil {
+		t.Fatal(err)
+	}
+
+	assertProto(t, subnet, &Subnet{
+		Ipv4CIDR:       "10.0.0.0/16",
+		DisplayName:    "private",
+		MaxHosts:       254,
+		DefaultGateway: &Gateway{NextHop: "10.0.0.1"},
+	})
+
+	// The protojson keys are the proto names
+	p, err := UnmarshalSubnet(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{
+		"ipv4CIDR":     "10.0.0.0/16",
+		"display_name": "private",
+		"max_hosts":    254,
+		"default_gateway": map[string]interface{}{
+			"nextHop": "10.0.0.1",
+		},
+	}
+
+	if !reflect.DeepEqual(p, want) {
+		t.Errorf("unmarshaled %v, want %v", p, want)
+	}
+
+	rd := marshalState(t, NewSubnetResource(), subnet, MarshalSubnetResourceData)
+
+	assertState(t, rd, "ipv4_cidr", "10.0.0.0/16")
+	assertState(t, rd, "label", "private")
+	assertState(t, rd, "capacity", 254)
+	assertState(t, rd, "default_gateway", []interface{}{
+		map[string]interface{}{"next_hop": "10.0.0.1"},
+	})
+
+}
+
+// The update mask paths are the proto names of the renamed attributes
+func TestSubnetUpdateMask(t *testing.T) {
+
+	rd := plannedResourceData(t, NewSubnetResource(), map[string]interface{}{
+		"ipv4_cidr": "10.0.0.0/16",
+		"capacity":  254,
+		"default_gateway": []interface{}{
+			map[string]interface{}{"next_hop": "10.0.0.1"},
+		},
+	}, map[string]interface{}{
+		"ipv4_cidr": "10.1.0.0/16",
+		"label":     "private",
+		"capacity":  126,
+		"default_gateway": []interface{}{
+			map[string]interface{}{"next_hop": "10.1.0.1"},
+		},
+	})
+
+	assertPaths(t, SubnetUpdateMask(rd), "ipv4CIDR", "display_name", "max_hosts", "default_gateway.nextHop")
+
+}
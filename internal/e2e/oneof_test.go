@@ -0,0 +1,135 @@
+package e2e
+
+import (
+	"testing"
+)
+
+func TestRouteOneofs(t *testing.T) {
+
+	route := &Route{}
+
+	err := unmarshalConfig(t, NewRouteResource(), map[string]interface{}{
+		"name":  "r",
+		"host":  "example.com",
+		"match": []interface{}{map[string]interface{}{"prefix": "/api"}},
+		"retry": []interface{}{map[string]interface{}{"fixed_ms": 100}},
+	}, UnmarshalRouteResourceData, route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertProto(t, route, &Route{
+		Name:   "r",
+		Target: &Route_Host{Host: "example.com"},
+		Match:  &Route_Prefix{Prefix: "/api"},
+		Retry:  &Retry{Backoff: &Retry_FixedMs{FixedMs: 100}},
+	})
+
+}
+
+func TestRouteRequiredOneof(t *testing.T) {
+
+	err := unmarshalConfig(t, NewRouteResource(), map[string]interface{}{
+		"name": "r",
+	}, UnmarshalRouteResourceData, &Route{})
+
+	assertError(t, err, `one of "host", "address" must be set for "target"`)
+
+}
+
+func TestRouteOneofInBlock(t *testing.T) {
+
+	err := unmarshalConfig(t, NewRouteResource(), map[string]interface{}{
+		"host":  "example.com",
+		"match": []interface{}{map[string]interface{}{"prefix": "/api", "exact": "/api"}},
+	}, UnmarshalRouteResourceData, &Route{})
+
+	assertError(t, err, `only one of "prefix", "exact" can be set for "match"`)
+
+}
+
+func TestRouteFlattenedOneofInNestedBlock(t *testing.T) {
+
+	err := unmarshalConfig(t, NewRouteResource(), map[string]interface{}{
+		"host":  "example.com",
+		"retry": []interface{}{map[string]interface{}{"fixed_ms": 100, "exponential_ms": 200}},
+	}, UnmarshalRouteResourceData, &Route{})
+
+	assertError(t, err, `only one of "fixed_ms", "exponential_ms" can be set for "backoff"`)
+
+}
+
+func TestRouteSchemaConstraints(t *testing.T) {
+
+	s := NewRouteSchema()
+
+	if got := s["host"].ExactlyOneOf; len(got) != 2 || got[0] != "host" || got[1] != "address" {
+		t.Errorf("host ExactlyOneOf = %v", got)
+	}
+
+}
+
+func TestRouteUpdateMask(t *testing.T) {
+
+	retry := func(backoff string, ms int) []interface{} {
+		return []interface{}{map[string]interface{}{backoff: ms}}
+	}
+
+	tests := map[string]struct {
+		prior, config map[string]interface{}
+		paths         []string
+	}{
+		"unchanged": {
+			prior:  map[string]interface{}{"host": "a", "retry": retry("fixed_ms", 10)},
+			config: map[string]interface{}{"host": "a", "retry": retry("fixed_ms", 10)},
+		},
+		"nested block attribute": {
+			prior:  map[string]interface{}{"host": "a", "retry": retry("fixed_ms", 10)},
+			config: map[string]interface{}{"host": "a", "retry": retry("fixed_ms", 20)},
+			paths:  []string{"retry.fixed_ms"},
+		},
+		"flattened oneof in nested block": {
+			prior:  map[string]interface{}{"host": "a", "retry": retry("fixed_ms", 10)},
+			config: map[string]interface{}{"host": "a", "retry": retry("exponential_ms", 10)},
+			paths:  []string{"retry.fixed_ms", "retry.exponential_ms"},
+		},
+		"block added": {
+			prior:  map[string]interface{}{"host": "a"},
+			config: map[string]interface{}{"host": "a", "retry": retry("fixed_ms", 10)},
+			paths:  []string{"retry"},
+		},
+		"block removed": {
+			prior:  map[string]interface{}{"host": "a", "retry": retry("fixed_ms", 10)},
+			config: map[string]interface{}{"host": "a"},
+			paths:  []string{"retry"},
+		},
+		"flattened oneof": {
+			prior:  map[string]interface{}{"host": "a"},
+			config: map[string]interface{}{"address": "10.0.0.1"},
+			paths:  []string{"host", "address"},
+		},
+		"oneof wrapper branch": {
+			prior:  map[string]interface{}{"host": "a", "match": []interface{}{map[string]interface{}{"prefix": "/api"}}},
+			config: map[string]interface{}{"host": "a", "match": []interface{}{map[string]interface{}{"exact": "/api"}}},
+			paths:  []string{"prefix", "exact"},
+		},
+		"oneof wrapper removed": {
+			prior:  map[string]interface{}{"host": "a", "match": []interface{}{map[string]interface{}{"prefix": "/api"}}},
+			config: map[string]interface{}{"host": "a"},
+			paths:  []string{"prefix"},
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+
+			rd := plannedResourceData(t, NewRouteResource(), test.prior, test.config)
+
+			assertPaths(t, RouteUpdateMask(rd), test.paths...)
+
+		})
+
+	}
+
+}
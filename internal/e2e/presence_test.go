@@ -0,0 +1,119 @@
+package e2e
+
+import (
+	"encoding/json"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// unmarshalProto converts the unmarshaled terraform values into the message
+func unmarshalProto(t *testing.T, p map[string]interface{}, m proto.Message) {
+
+	t.Helper()
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := protojson.Unmarshal(b, m); err != nil {
+		t.Fatalf("unmarshaling %s: %v", b, err)
+	}
+
+}
+
+func TestCounterPresence(t *testing.T) {
+
+	rd := configuredResourceData(t, NewCounterResource(), map[string]interface{}{
+		"limit": 0,
+		"plain": 0,
+		"window": []interface{}{
+			map[string]interface{}{"size": 0},
+		},
+		"windows": []interface{}{
+			map[string]interface{}{"note": ""},
+			map[string]interface{}{"size": 3},
+		},
+		"source": []interface{}{
+			map[string]interface{}{
+				"fixed": []interface{}{
+					map[string]interface{}{"size": 0},
+				},
+			},
+		},
+	})
+
+	p, err := UnmarshalCounterResourceData(rd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	counter := &Counter{}
+
+	unmarshalProto(t, p, counter)
+
+	assertProto(t, counter, &Counter{
+		Limit:  proto.Int32(0),
+		Window: &Window{Size: proto.Int32(0)},
+		Windows: []*Window{
+			{Note: proto.String("")},
+			{Size: proto.Int32(3)},
+		},
+		Source: &Counter_Fixed{Fixed: &Window{Size: proto.Int32(0)}},
+	})
+
+}
+
+func TestCounterPresenceWithoutConfig(t *testing.T) {
+
+	// Outside of the plan and apply only the non-zero values are known to be set
+	counter := &Counter{}
+
+	err := unmarshalConfig(t, NewCounterResource(), map[string]interface{}{
+		"limit":   0,
+		"enabled": true,
+		"window": []interface{}{
+			map[string]interface{}{"size": 0, "note": "n"},
+		},
+	}, UnmarshalCounterResourceData, counter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertProto(t, counter, &Counter{
+		Enabled: proto.Bool(true),
+		Window:  &Window{Note: proto.String("n")},
+	})
+
+}
+
+func TestWindowPresence(t *testing.T) {
+
+	// Without configuration the keys of the object are the set attributes
+	p, err := UnmarshalCounter(map[string]interface{}{
+		"enabled": false,
+		"window": []interface{}{
+			map[string]interface{}{
+				"size": 0,
+				"config": []interface{}{
+					map[string]interface{}{"retries": 0},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	counter := &Counter{}
+
+	unmarshalProto(t, p, counter)
+
+	assertProto(t, counter, &Counter{
+		Enabled: proto.Bool(false),
+		Window:  &Window{Size: proto.Int32(0), Config: &Window_Config{Retries: proto.Int32(0)}},
+	})
+
+}
@@ -0,0 +1,75 @@
+package e2e
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFolderRecursion(t *testing.T) {
+
+	folder := &Folder{}
+
+	err := unmarshalConfig(t, NewFolderResource(), map[string]interface{}{
+		"name": "root",
+		"tree": []interface{}{
+			map[string]interface{}{
+				"label": "a",
+				"children": []interface{}{
+					map[string]interface{}{
+						"label":    "b",
+						"children": `[{"label": "c"}]`,
+					},
+				},
+			},
+		},
+		"filter": []interface{}{
+			map[string]interface{}{
+				"value": "x",
+				"not":   `{"value": "y"}`,
+			},
+		},
+	}, UnmarshalFolderResourceData, folder)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Folder{
+		Name: "root",
+		Tree: &Tree{
+			Label: "a",
+			Children: []*Tree{
+				{Label: "b", Children: []*Tree{{Label: "c"}}},
+			},
+		},
+		Filter: &Expr{Value: "x", Not: &Expr{Value: "y"}},
+	}
+
+	assertProto(t, folder, want)
+
+	rd := marshalState(t, NewFolderResource(), folder, MarshalFolderResourceData)
+
+	assertState(t, rd, "tree.0.children.0.label", "b")
+	assertState(t, rd, "tree.0.children.0.children", `[{"label":"c"}]`)
+	assertState(t, rd, "filter.0.not", `{"value":"y"}`)
+
+}
+
+func TestFolderRecursionDepth(t *testing.T) {
+
+	// max_depth defaults to 0, the recursive field is a JSON string
+	if typ := NewExprSchema()["not"].Type; typ != schema.TypeString {
+		t.Errorf("not type = %v, want %v", typ, schema.TypeString)
+	}
+
+	children := NewTreeSchema()["children"]
+
+	if children.Type != schema.TypeList {
+		t.Fatalf("children type = %v, want %v", children.Type, schema.TypeList)
+	}
+
+	if typ := children.Elem.(*schema.Resource).Schema["children"].Type; typ != schema.TypeString {
+		t.Errorf("nested children type = %v, want %v", typ, schema.TypeString)
+	}
+
+}
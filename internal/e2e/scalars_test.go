@@ -0,0 +1,193 @@
+package e2e
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestQuotaIntegers(t *testing.T) {
+
+	quota := &Quota{}
+
+	err := unmarshalConfig(t, NewQuotaResource(), map[string]interface{}{
+		"count":      -7,
+		"bytes_used": math.MaxInt64,
+		"requests":   math.MaxInt64,
+		"balance":    math.MinInt64,
+		"serial":     "18446744073709551615",
+		"samples":    []interface{}{math.MaxInt64, -1},
+		"limits":     map[string]interface{}{"cpu": 9007199254740993},
+	}, UnmarshalQuotaResourceData, quota)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Quota{
+		Count:     -7,
+		BytesUsed: math.MaxInt64,
+		Requests:  math.MaxInt64,
+		Balance:   math.MinInt64,
+		Serial:    math.MaxUint64,
+		Samples:   []int64{math.MaxInt64, -1},
+		Limits:    map[string]uint64{"cpu": 9007199254740993},
+	}
+
+	assertProto(t, quota, want)
+
+	rd := marshalState(t, NewQuotaResource(), quota, MarshalQuotaResourceData)
+
+	assertState(t, rd, "count", -7)
+	assertState(t, rd, "bytes_used", math.MaxInt64)
+	assertState(t, rd, "balance", math.MinInt64)
+	assertState(t, rd, "serial", "18446744073709551615")
+	assertState(t, rd, "samples", []interface{}{math.MaxInt64, -1})
+	assertState(t, rd, "limits", map[string]interface{}{"cpu": 9007199254740993})
+
+}
+
+func TestQuotaIntegerOverflow(t *testing.T) {
+
+	_, err := MarshalQuotaProto(&Quota{Limits: map[string]uint64{"cpu": math.MaxUint64}})
+
+	assertError(t, err, "18446744073709551615 of limits overflows a terraform integer, map values are limited to the int64 range")
+
+	_, err = MarshalQuotaProto(&Quota{Requests: math.MaxUint64})
+
+	assertError(t, err, "18446744073709551615 of requests overflows a terraform integer, use int64_as_string")
+
+	s := NewQuotaSchema()
+
+	assertValid(t, s["serial"], "18446744073709551615", true)
+	assertValid(t, s["serial"], "-1", false)
+
+}
+
+// Callers decoding protojson without UseNumber get float64 numbers
+func TestMarshalFloat64Numbers(t *testing.T) {
+
+	tests := []struct {
+		marshal func(map[string]interface{}) (map[string]interface{}, error)
+		json    string
+		want    map[string]interface{}
+	}{
+		{
+			marshal: MarshalQuota,
+			json:    `{"count": -7, "bytes_used": "12"}`,
+			want:    map[string]interface{}{"count": int64(-7), "bytes_used": int64(12)},
+		},
+		{
+			marshal: MarshalGauge,
+			json:    `{"value": 1.5, "ratio": 0.25, "samples": [2, "Infinity"]}`,
+			want:    map[string]interface{}{"value": 1.5, "ratio": 0.25, "samples": []interface{}{2.0, math.Inf(1)}},
+		},
+	}
+
+	for _, test := range tests {
+
+		obj := map[string]interface{}{}
+
+		if err := json.Unmarshal([]byte(test.json), &obj); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := test.marshal(obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("marshaled %s into %#v, want %#v", test.json, got, test.want)
+		}
+
+	}
+
+}
+
+func TestBlobBytes(t *testing.T) {
+
+	blob := &Blob{}
+
+	err := unmarshalConfig(t, NewBlobResource(), map[string]interface{}{
+		"data":   "AAH/",
+		"text":   "héllo",
+		"chunks": []interface{}{"a", "b"},
+	}, UnmarshalBlobResourceData, blob)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertProto(t, blob, &Blob{
+		Data:   []byte{0, 1, 255},
+		Text:   []byte("héllo"),
+		Chunks: [][]byte{[]byte("a"), []byte("b")},
+	})
+
+	rd := marshalState(t, NewBlobResource(), blob, MarshalBlobResourceData)
+
+	assertState(t, rd, "data", "AAH/")
+	assertState(t, rd, "text", "héllo")
+	assertState(t, rd, "chunks", []interface{}{"a", "b"})
+
+	assertValid(t, NewBlobSchema()["data"], "not base64!", false)
+
+}
+
+func TestGaugeFloats(t *testing.T) {
+
+	gauge := &Gauge{}
+
+	err := unmarshalConfig(t, NewGaugeResource(), map[string]interface{}{
+		"value":   1.5,
+		"ratio":   0.1,
+		"samples": []interface{}{0.25, -3},
+	}, UnmarshalGaugeResourceData, gauge)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertProto(t, gauge, &Gauge{Value: 1.5, Ratio: 0.1, Samples: []float64{0.25, -3}})
+
+	// Special values are protojson strings
+	p, err := UnmarshalGauge(map[string]interface{}{
+		"value":   math.Inf(-1),
+		"samples": []interface{}{math.NaN(), math.Inf(1)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p["value"] != "-Infinity" || p["samples"].([]interface{})[0] != "NaN" || p["samples"].([]interface{})[1] != "Infinity" {
+		t.Errorf("got %v, want the special values as strings", p)
+	}
+
+	_, err = UnmarshalGauge(map[string]interface{}{"ratio": math.MaxFloat64})
+
+	assertError(t, err, "1.7976931348623157e+308 of ratio overflows a float32")
+
+	m, err := MarshalGaugeProto(&Gauge{Value: math.Inf(1), Samples: []float64{math.NaN()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !math.IsInf(m["value"].(float64), 1) || !math.IsNaN(m["samples"].([]interface{})[0].(float64)) {
+		t.Errorf("got %v, want the special values as floats", m)
+	}
+
+}
+
+func TestGaugeFloat32Rounding(t *testing.T) {
+
+	ratio := NewGaugeSchema()["ratio"]
+
+	// The API reads back the float32 of the configured value
+	if !ratio.DiffSuppressFunc("ratio", "0.10000000149011612", "0.1", nil) {
+		t.Error("float32 rounding of 0.1 is not suppressed")
+	}
+
+	if ratio.DiffSuppressFunc("ratio", "0.2", "0.1", nil) {
+		t.Error("different values are suppressed")
+	}
+
+}
@@ -0,0 +1,98 @@
+package e2e
+
+import (
+	"testing"
+)
+
+func TestCredentialKeepsConfiguredSecrets(t *testing.T) {
+
+	rd := configuredResourceData(t, NewCredentialResource(), map[string]interface{}{
+		"name":         "db",
+		"password":     "hunter2",
+		"token":        "t0k3n",
+		"rotation_key": "k3y",
+	})
+
+	// The API redacts the password, never returns the token and returns the other attributes
+	diags := MarshalCredentialResourceData(&Credential{
+		Name:     "db-renamed",
+		Password: "********",
+		Rotation: &Credential_RotationKey{RotationKey: "k3y-2"},
+	}, rd)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	assertState(t, rd, "name", "db-renamed")
+	assertState(t, rd, "password", "hunter2")
+	assertState(t, rd, "token", "t0k3n")
+	assertState(t, rd, "rotation_key", "k3y-2")
+
+}
+
+func TestCredentialSensitiveAttributes(t *testing.T) {
+
+	s := NewCredentialSchema()
+
+	for _, key := range []string{"password", "rotation_key"} {
+
+		if !s[key].Sensitive {
+			t.Errorf("%s should be sensitive", key)
+		}
+
+	}
+
+	if !NewCredentialOwnerSchema()["phone"].Sensitive {
+		t.Error("owner.phone should be sensitive")
+	}
+
+	// Sensitive attributes of blocks are read back
+	rd := marshalState(t, NewCredentialResource(), &Credential{
+		Name:  "db",
+		Owner: &CredentialOwner{Email: "ops@example.com", Phone: "555-0100"},
+		Auth:  &Credential_ApiKey{ApiKey: "s3cr3t"},
+	}, MarshalCredentialResourceData)
+
+	assertState(t, rd, "owner", []interface{}{
+		map[string]interface{}{"email": "ops@example.com", "phone": "555-0100"},
+	})
+	assertState(t, rd, "auth", []interface{}{
+		map[string]interface{}{"api_key": "s3cr3t", "certificate": ""},
+	})
+
+}
+
+func TestCredentialClearsDroppedValues(t *testing.T) {
+
+	rd := configuredResourceData(t, NewCredentialResource(), map[string]interface{}{
+		"name":          "db",
+		"rotation_days": 30,
+		"owner": []interface{}{
+			map[string]interface{}{"email": "ops@example.com"},
+		},
+	})
+
+	// The API dropped the rotation and the owner
+	if diags := MarshalCredentialResourceData(&Credential{Name: "db"}, rd); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	assertState(t, rd, "name", "db")
+	assertState(t, rd, "rotation_days", 0)
+	assertState(t, rd, "owner", []interface{}{})
+
+}
+
+func TestSeedWithoutStateAttributes(t *testing.T) {
+
+	rd := configuredResourceData(t, NewSeedResource(), map[string]interface{}{
+		"value": "s33d",
+	})
+
+	if diags := MarshalSeedResourceData(&Seed{Value: "other"}, rd); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	assertState(t, rd, "value", "s33d")
+
+}
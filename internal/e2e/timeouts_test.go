@@ -0,0 +1,24 @@
+package e2e
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGadgetTimeouts(t *testing.T) {
+
+	r := NewGadgetResource()
+
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatal(err)
+	}
+
+	if *r.Timeouts.Create != 30*time.Minute || *r.Timeouts.Delete != 90*time.Minute {
+		t.Errorf("timeouts = %+v", r.Timeouts)
+	}
+
+	if r.Timeouts.Read != nil || r.Timeouts.Update != nil {
+		t.Errorf("read and update timeouts should be the SDK defaults: %+v", r.Timeouts)
+	}
+
+}
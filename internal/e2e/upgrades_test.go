@@ -0,0 +1,57 @@
+package e2e
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestGadgetStateUpgrades(t *testing.T) {
+
+	r := NewGadgetResource()
+
+	if r.SchemaVersion != 2 || len(r.StateUpgraders) != 2 {
+		t.Fatalf("schema version %d with %d upgraders", r.SchemaVersion, len(r.StateUpgraders))
+	}
+
+	state := map[string]interface{}{
+		"title": "gizmo",
+		"config": []interface{}{
+			map[string]interface{}{"size": 3},
+		},
+		"owner": []interface{}{
+			map[string]interface{}{"team": "ops", "email": "ops@example.com"},
+		},
+	}
+
+	for _, upgrader := range r.StateUpgraders {
+
+		var err error
+
+		state, err = upgrader.Upgrade(context.Background(), state, nil)
+		if err != nil {
+			t.Fatalf("upgrading version %d: %v", upgrader.Version, err)
+		}
+
+	}
+
+	// The emptied block is removed, the owner block keeps the email which the SDK
+	// removes with the block as it is not in the current schema
+	want := map[string]interface{}{
+		"display_name": "gizmo",
+		"size":         3,
+		"team":         "ops",
+		"owner": []interface{}{
+			map[string]interface{}{"email": "ops@example.com"},
+		},
+	}
+
+	if !reflect.DeepEqual(state, want) {
+		t.Errorf("state = %#v, want %#v", state, want)
+	}
+
+	if state, err := GadgetStateUpgradeV0(context.Background(), nil, nil); err != nil || state != nil {
+		t.Errorf("upgrading no state: got %v, %v", state, err)
+	}
+
+}
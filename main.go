@@ -1,33 +1,69 @@
 package main
 
 import (
+	"os"
+
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/pluginpb"
 )
 
 func main() {
-	protogen.Options{}.Run(func(plugin *protogen.Plugin) error {
 
-		for _, f := range plugin.Files {
-			if !f.Generate {
-				continue
-			}
+	// Breaking changes detector, protoc runs the plugin without arguments
+	if len(os.Args) > 1 && os.Args[1] == "breaking" {
+		os.Exit(runBreaking(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
+	protogen.Options{}.Run(generate)
+}
+
+// generate writes the terraform files of the files to generate
+func generate(plugin *protogen.Plugin) error {
 
-			fInfo := newFileInfo(f)
+	plugin.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
 
-			generateFile(plugin, fInfo)
+	files := map[string]*fileInfo{}
 
+	for _, f := range plugin.Files {
+		if !f.Generate {
+			continue
 		}
 
-		return nil
+		fInfo := newFileInfo(f)
+		fInfo.importNeeds.discoverFiles(plugin.Files)
+
+		files[f.Desc.Path()] = fInfo
+
+	}
+
+	// Referenced messages must be known before generating any file,
+	// they can live in other files of this invocation
+	for _, f := range plugin.Files {
+		if !f.Generate {
+			continue
+		}
+
+		if err := files[f.Desc.Path()].discoverFile(files); err != nil {
+			return err
+		}
+
+	}
+
+	for _, f := range plugin.Files {
+		if !f.Generate {
+			continue
+		}
+
+		generateFile(plugin, files[f.Desc.Path()])
+
+	}
+
+	return nil
 
-	})
 }
 
 func generateFile(plugin *protogen.Plugin, fInfo *fileInfo) *fileInfo {
 
-	fInfo.discoverFile()
-	fInfo.importNeeds.discoverFiles(plugin.Files)
-
 	if len(fInfo.messages) == 0 {
 		return fInfo
 	}
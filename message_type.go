@@ -2,6 +2,9 @@ package main
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/iancoleman/strcase"
 	terraformpb "github.com/protomesh/protoc-gen-terraform/proto/terraform"
@@ -11,140 +14,1188 @@ import (
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
+// Placeholders of the fields in the ID template
+var idPlaceholderRegexp = regexp.MustCompile(`\{([^}]+)\}`)
+
 type messageInfo struct {
 	fInfo *fileInfo
 
 	value  *protogen.Message
 	schema *terraformpb.MessageSchema
 
-	marshalFunctionName   string
-	unmarshalFunctionName string
-	schemaFunctionName    string
+	marshalFunctionName   string
+	unmarshalFunctionName string
+
+	unmarshalConfigFunctionName string
+	schemaFunctionName          string
+	resourceFunctionName        string
+	updateMaskFunctionName      string
+	setIDFunctionName           string
+	parseIDFunctionName         string
+
+	stateUpgradeFunctionName string
+
+	okVar    string
+	valueVar string
+
+	sourceVar string
+	source    string
+	selector  string
+
+	// Configuration of the block, it tells the attributes set to their zero values
+	configVar string
+}
+
+func newMessageInfo(fInfo *fileInfo, value *protogen.Message) *messageInfo {
+
+	fullName := getDescriptorFullName(value.Desc, "")
+	varName := strcase.ToLowerCamel(fullName)
+
+	// panic(fmt.Sprintf("%s\n%s", value.Location.Path.String(), value.GoIdent.GoImportPath, value.GoIdent.GoName))
+
+	mInfo := &messageInfo{
+		fInfo: fInfo,
+
+		value:  value,
+		schema: getMessageSchema(value.Desc),
+
+		marshalFunctionName:   fmt.Sprintf("Marshal%s", fullName),
+		unmarshalFunctionName: fmt.Sprintf("Unmarshal%s", fullName),
+
+		unmarshalConfigFunctionName: fmt.Sprintf("Unmarshal%sWithConfig", fullName),
+		schemaFunctionName:          fmt.Sprintf("New%sSchema", fullName),
+		resourceFunctionName:        fmt.Sprintf("New%sResource", fullName),
+
+		updateMaskFunctionName: fmt.Sprintf("%sUpdateMask", fullName),
+		setIDFunctionName:      fmt.Sprintf("%sSetID", fullName),
+		parseIDFunctionName:    fmt.Sprintf("%sParseID", fullName),
+
+		stateUpgradeFunctionName: fmt.Sprintf("%sStateUpgradeV", fullName),
+
+		okVar:    fmt.Sprintf("ok%s", varName),
+		valueVar: fmt.Sprintf("value%s", varName),
+
+		sourceVar: "obj",
+		source:    "obj map[string]interface{}",
+		selector:  "obj[\"%s\"]",
+
+		configVar: "config",
+	}
+
+	return mInfo
+}
+
+func getMessageSchema(desc protoreflect.MessageDescriptor) *terraformpb.MessageSchema {
+
+	opts, ok := desc.Options().(*descriptorpb.MessageOptions)
+	if !ok {
+		panic("Invalid message options")
+	}
+
+	if opts != nil && proto.HasExtension(opts, terraformpb.E_MessageSchema) {
+
+		// https://stackoverflow.com/questions/28815214/how-to-set-get-protobufs-extension-field-in-go
+		return proto.GetExtension(opts, terraformpb.E_MessageSchema).(*terraformpb.MessageSchema)
+
+	}
+
+	return &terraformpb.MessageSchema{
+		Generate:   false,
+		IsResource: false,
+	}
+
+}
+
+// isRecursive reports if the message can be reached from its own fields
+func (mInfo *messageInfo) isRecursive() bool {
+
+	for _, field := range mInfo.value.Fields {
+
+		fdInfo := newFieldInfo(mInfo.fInfo, field)
+
+		if !fdInfo.schema.Ignore && fdInfo.isRecursive() {
+			return true
+		}
+
+	}
+
+	return false
+
+}
+
+// writeDepthWrapper writes a function starting the recursion of its Depth variant at the maximum depth,
+// the arguments are the names of the params passed through
+func (mInfo *messageInfo) writeDepthWrapper(t tab, gen *protogen.GeneratedFile, functionName, params string, args []string, results string) {
+
+	args = append(args, fmt.Sprintf("%d", mInfo.schema.MaxDepth))
+
+	t.P(gen, `func `, functionName, `(`, params, `) `, results, ` {`)
+
+	t++
+
+	t.P(gen, `return `, functionName, `Depth(`, strings.Join(args, ", "), `)`)
+
+	t--
+
+	t.P(gen, `}`)
+
+}
+
+func (mInfo *messageInfo) prefixWithPackage(suffix string) string {
+
+	if mInfo.fInfo.file.GoImportPath != mInfo.value.GoIdent.GoImportPath {
+
+		i := mInfo.fInfo.importNeeds.getPackageForMessage(mInfo.value)
+
+		if len(i) == 0 {
+			i = mInfo.fInfo.importNeeds.getPackage(mInfo.value.GoIdent)
+		}
+
+		suffix = fmt.Sprintf("%s%s", i, suffix)
+	}
+
+	return suffix
+}
+
+func (mInfo *messageInfo) makeSelector(fieldKey string) string {
+	return fmt.Sprintf(mInfo.selector, fieldKey)
+}
+
+func (mInfo *messageInfo) getConfigVar() string {
+	return mInfo.configVar
+}
+
+func (mInfo *messageInfo) makeMapIndex(fieldKey string) string {
+	return fmt.Sprintf(`p["%s"]`, fieldKey)
+}
+
+// checkAttributeNames reports two attributes of the same block ending up with the same terraform name
+func (mInfo *messageInfo) checkAttributeNames() error {
+
+	check := func(owners map[string]string, key, owner string) error {
+
+		if other, ok := owners[key]; ok {
+			return fmt.Errorf("duplicate terraform attribute %q in message %s (%s and %s)", key, mInfo.value.Desc.FullName(), other, owner)
+		}
+
+		owners[key] = owner
+
+		return nil
+
+	}
+
+	owners := map[string]string{}
+
+	for _, field := range mInfo.value.Fields {
+
+		fdInfo := newFieldInfo(mInfo.fInfo, field)
+
+		if fdInfo.schema.Ignore {
+			continue
+		}
+
+		if !isOneofMember(field) || newOneOfInfo(mInfo.fInfo, field.Oneof).schema.Flatten {
+
+			if err := check(owners, fdInfo.fieldKey, string(field.Desc.Name())); err != nil {
+				return err
+			}
+
+		}
+
+	}
+
+	for _, attr := range mInfo.schema.VirtualAttributes {
+
+		if err := check(owners, attr.Name, "virtual attribute"); err != nil {
+			return err
+		}
+
+	}
+
+	for _, attr := range mInfo.patternAttributes() {
+
+		if err := check(owners, attr.Name, "resource name segment"); err != nil {
+			return err
+		}
+
+	}
+
+	for _, oneOf := range realOneofs(mInfo.value) {
+
+		oInfo := newOneOfInfo(mInfo.fInfo, oneOf)
+
+		if oInfo.schema.Flatten {
+			continue
+		}
+
+		if err := check(owners, oInfo.oneOfKey, string(oneOf.Desc.Name())); err != nil {
+			return err
+		}
+
+		oneOfOwners := map[string]string{}
+
+		for _, field := range oInfo.fields() {
+
+			if err := check(oneOfOwners, newFieldInfo(mInfo.fInfo, field).fieldKey, string(field.Desc.Name())); err != nil {
+				return err
+			}
+
+		}
+
+	}
+
+	return nil
+
+}
+
+func writeVirtualAttributeSchema(t tab, gen *protogen.GeneratedFile, attr *terraformpb.VirtualAttribute) {
+
+	t.P(gen, `"`, attr.Name, `": {`)
+
+	t++
+
+	desc := describeVirtualAttribute(attr)
+
+	desc.writeSchemaType(t, gen)
+	desc.writeSchemaBehavior(t, gen)
+
+	if len(attr.Description) > 0 {
+		t.P(gen, `Description: "`, commentToString(protogen.Comments(attr.Description)), `",`)
+	}
+
+	t--
+
+	t.P(gen, `},`)
+
+}
+
+func (mInfo *messageInfo) writeSchemaFunction(t tab, gen *protogen.GeneratedFile) {
+
+	if mInfo.isRecursive() {
+
+		mInfo.writeDepthWrapper(t, gen, mInfo.schemaFunctionName, ``, nil, `map[string]*schema.Schema`)
+		gen.P()
+
+		t.P(gen, `func `, mInfo.schemaFunctionName, `Depth(depth int) map[string]*schema.Schema {`)
+
+	} else {
+		t.P(gen, `func `, mInfo.schemaFunctionName, `() map[string]*schema.Schema {`)
+	}
+
+	t++
+
+	t.P(gen, `return map[string]*schema.Schema{`)
+
+	t++
+
+	for _, field := range mInfo.value.Fields {
+
+		if !isOneofMember(field) {
+
+			fdInfo := newFieldInfo(mInfo.fInfo, field)
+
+			fdInfo.writeSchema(t, gen)
+
+		}
+
+	}
+
+	for _, oneOf := range realOneofs(mInfo.value) {
+
+		oInfo := newOneOfInfo(mInfo.fInfo, oneOf)
+
+		oInfo.writeSchema(t, gen)
+
+	}
+
+	for _, attr := range mInfo.schema.VirtualAttributes {
+
+		writeVirtualAttributeSchema(t, gen, attr)
+
+	}
+
+	for _, attr := range mInfo.patternAttributes() {
+
+		writeVirtualAttributeSchema(t, gen, attr)
+
+	}
+
+	t--
+
+	t.P(gen, `}`)
+
+	t--
+
+	t.P(gen, `}`)
+
+}
+
+func (mInfo *messageInfo) deprecationMessage() string {
+
+	if len(mInfo.schema.DeprecationMessage) > 0 {
+		return commentToString(protogen.Comments(mInfo.schema.DeprecationMessage))
+	}
+
+	if opts, ok := mInfo.value.Desc.Options().(*descriptorpb.MessageOptions); ok && opts.GetDeprecated() {
+		return fmt.Sprintf(defaultDeprecationMessage, "resource")
+	}
+
+	return ""
+
+}
+
+// resourceTimeout is a default timeout of a resource operation
+type resourceTimeout struct {
+	operation string
+	duration  time.Duration
+}
+
+// timeouts returns the default timeouts set in the message schema, parsed at generation time
+func (mInfo *messageInfo) timeouts() []resourceTimeout {
+
+	timeouts := []resourceTimeout{}
+
+	if mInfo.schema.Timeouts == nil {
+		return timeouts
+	}
+
+	for _, op := range []struct {
+		operation string
+		value     string
+	}{
+		{"Create", mInfo.schema.Timeouts.Create},
+		{"Read", mInfo.schema.Timeouts.Read},
+		{"Update", mInfo.schema.Timeouts.Update},
+		{"Delete", mInfo.schema.Timeouts.Delete},
+	} {
+
+		if len(op.value) == 0 {
+			continue
+		}
+
+		duration, err := time.ParseDuration(op.value)
+		if err != nil {
+			panic(fmt.Sprintf("invalid %s timeout of %s: %v", strings.ToLower(op.operation), mInfo.value.Desc.FullName(), err))
+		}
+
+		if duration <= 0 {
+			panic(fmt.Sprintf("%s timeout of %s must be positive", strings.ToLower(op.operation), mInfo.value.Desc.FullName()))
+		}
+
+		timeouts = append(timeouts, resourceTimeout{operation: op.operation, duration: duration})
+
+	}
+
+	return timeouts
+
+}
+
+// resourceDescription returns the message comments followed by the default timeouts
+func (mInfo *messageInfo) resourceDescription() string {
+
+	description := commentToString(mInfo.value.Comments.Leading)
+
+	timeouts := mInfo.timeouts()
+
+	if len(timeouts) == 0 {
+		return description
+	}
+
+	defaults := []string{}
+
+	for _, timeout := range timeouts {
+		defaults = append(defaults, fmt.Sprintf("%s %s", strings.ToLower(timeout.operation), timeout.duration))
+	}
+
+	if len(description) > 0 && !strings.HasSuffix(description, ".") {
+		description += "."
+	}
+
+	if len(description) > 0 {
+		description += " "
+	}
+
+	return description + "Default timeouts: " + strings.Join(defaults, ", ") + "."
+
+}
+
+// goDurationExpr returns the Go expression of the duration in its largest exact unit
+func goDurationExpr(d time.Duration) string {
+
+	for _, unit := range []struct {
+		name     string
+		duration time.Duration
+	}{
+		{"time.Hour", time.Hour},
+		{"time.Minute", time.Minute},
+		{"time.Second", time.Second},
+		{"time.Millisecond", time.Millisecond},
+		{"time.Microsecond", time.Microsecond},
+	} {
+
+		if d%unit.duration == 0 {
+			return fmt.Sprintf("%d * %s", d/unit.duration, unit.name)
+		}
+
+	}
+
+	return fmt.Sprintf("%d * time.Nanosecond", d)
+
+}
+
+func (mInfo *messageInfo) writeTimeouts(t tab, gen *protogen.GeneratedFile, timeouts []resourceTimeout) {
+
+	t.P(gen, `Timeouts: &schema.ResourceTimeout{`)
+
+	t++
+
+	for _, timeout := range timeouts {
+		t.P(gen, timeout.operation, `: schema.DefaultTimeout(`, goDurationExpr(timeout.duration), `),`)
+	}
+
+	t--
+
+	t.P(gen, `},`)
+
+}
+
+func (mInfo *messageInfo) writeResourceFunction(t tab, gen *protogen.GeneratedFile) {
+
+	t.P(gen, `func `, mInfo.resourceFunctionName, `() *schema.Resource {`)
+
+	t++
+
+	t.P(gen, `return &schema.Resource{`)
+
+	t++
+
+	t.P(gen, `Schema: `, mInfo.schemaFunctionName, `(),`)
+
+	if description := mInfo.resourceDescription(); len(description) > 0 {
+		t.P(gen, `Description: "`, description, `",`)
+	}
+
+	if deprecation := mInfo.deprecationMessage(); len(deprecation) > 0 {
+		t.P(gen, `DeprecationMessage: "`, deprecation, `",`)
+	}
+
+	if mInfo.hasID() {
+		mInfo.writeImporter(t, gen)
+	}
+
+	if timeouts := mInfo.timeouts(); len(timeouts) > 0 {
+		mInfo.writeTimeouts(t, gen, timeouts)
+	}
+
+	if mInfo.schema.SchemaVersion > 0 {
+		mInfo.writeStateUpgraders(t, gen)
+	}
+
+	t--
+
+	t.P(gen, `}`)
+
+	t--
+
+	t.P(gen, `}`)
+
+}
+
+// idFields returns the fields of the ID template placeholders in order
+func (mInfo *messageInfo) idFields() []*fieldInfo {
+
+	fields := []*fieldInfo{}
+
+	for _, match := range idPlaceholderRegexp.FindAllStringSubmatch(mInfo.schema.IdFields, -1) {
+
+		field := mInfo.fieldByProtoName(match[1])
+
+		if field == nil {
+			panic(fmt.Sprintf("id_fields of %s references %q which is not a field", mInfo.value.Desc.FullName(), match[1]))
+		}
+
+		fdInfo := newFieldInfo(mInfo.fInfo, field)
+
+		if fdInfo.schema.Ignore || field.Message != nil || field.Desc.IsList() || (isOneofMember(field) && !newOneOfInfo(mInfo.fInfo, field.Oneof).schema.Flatten) {
+			panic(fmt.Sprintf("id field %q of %s must be a scalar attribute of the resource", match[1], mInfo.value.Desc.FullName()))
+		}
+
+		if goType := fdInfo.getFieldGoType(); goType != "string" && goType != "int" {
+			panic(fmt.Sprintf("id field %q of %s must be a string or an integer", match[1], mInfo.value.Desc.FullName()))
+		}
+
+		fields = append(fields, fdInfo)
+
+	}
+
+	if len(fields) == 0 {
+		panic(fmt.Sprintf("id_fields of %s has no {field} placeholder", mInfo.value.Desc.FullName()))
+	}
+
+	return fields
+
+}
+
+func (mInfo *messageInfo) fieldByProtoName(name string) *protogen.Field {
+
+	for _, field := range mInfo.value.Fields {
+
+		if string(field.Desc.Name()) == name {
+			return field
+		}
+
+	}
+
+	return nil
+
+}
+
+// nameDescriptor returns the google.api.resource option the ID is derived from,
+// id_fields take precedence over it
+func (mInfo *messageInfo) nameDescriptor() *resourceDescriptor {
+
+	if !mInfo.schema.IsResource || len(mInfo.schema.IdFields) > 0 {
+		return nil
+	}
+
+	return getResourceDescriptor(mInfo.value.Desc)
+
+}
+
+func (mInfo *messageInfo) hasID() bool {
+	return len(mInfo.schema.IdFields) > 0 || mInfo.nameDescriptor() != nil
+}
+
+// nameStringField returns the string attribute of the field, nil if the message has no such field
+func (mInfo *messageInfo) nameStringField(name string) *fieldInfo {
+
+	field := mInfo.fieldByProtoName(name)
+
+	if field == nil {
+		return nil
+	}
+
+	fdInfo := newFieldInfo(mInfo.fInfo, field)
+
+	if fdInfo.schema.Ignore || field.Desc.Kind() != protoreflect.StringKind || field.Desc.IsList() || field.Desc.IsMap() || (isOneofMember(field) && !newOneOfInfo(mInfo.fInfo, field.Oneof).schema.Flatten) {
+		panic(fmt.Sprintf("resource name field %q of %s must be a string attribute of the resource", name, mInfo.value.Desc.FullName()))
+	}
+
+	return fdInfo
+
+}
+
+// patternAttributes returns the computed attributes of the resource name segments
+// which are not fields of the message
+func (mInfo *messageInfo) patternAttributes() []*terraformpb.VirtualAttribute {
+
+	attrs := []*terraformpb.VirtualAttribute{}
+
+	rd := mInfo.nameDescriptor()
+	if rd == nil {
+		return attrs
+	}
+
+	for _, segment := range rd.segments() {
+
+		if mInfo.nameStringField(segment) != nil {
+			continue
+		}
+
+		attrs = append(attrs, &terraformpb.VirtualAttribute{
+			Name:        segment,
+			Type:        terraformpb.AttributeType_ATTRIBUTE_TYPE_STRING,
+			Computed:    true,
+			Description: fmt.Sprintf("The %s segment of the resource name.", segment),
+		})
+
+	}
+
+	return attrs
+
+}
+
+// segmentKey returns the attribute holding the resource name segment
+func (mInfo *messageInfo) segmentKey(segment string) string {
+
+	if fdInfo := mInfo.nameStringField(segment); fdInfo != nil {
+		return fdInfo.fieldKey
+	}
+
+	return segment
+
+}
+
+// writeIDFunctions writes the functions building the resource ID from a message and
+// parsing the identifying attributes back from an ID
+func (mInfo *messageInfo) writeIDFunctions(t tab, gen *protogen.GeneratedFile) {
+
+	if rd := mInfo.nameDescriptor(); rd != nil {
+		mInfo.writePatternIDFunctions(t, gen, rd)
+		return
+	}
+
+	fields := mInfo.idFields()
+	literals := idPlaceholderRegexp.Split(mInfo.schema.IdFields, -1)
+
+	format := ""
+	pattern := "^"
+	args := []string{}
+
+	for i, fdInfo := range fields {
+
+		format += strings.ReplaceAll(literals[i], "%", "%%") + "%v"
+		pattern += regexp.QuoteMeta(literals[i]) + "([^/]+)"
+		args = append(args, fmt.Sprintf(`pMap["%s"]`, fdInfo.fieldKey))
+
+	}
+
+	format += strings.ReplaceAll(literals[len(fields)], "%", "%%")
+	pattern += regexp.QuoteMeta(literals[len(fields)]) + "$"
+
+	t.P(gen, `func `, mInfo.setIDFunctionName, `(rd *schema.ResourceData, m proto.Message) error {`)
+
+	t++
+
+	t.P(gen, `pMap, err := `, mInfo.marshalFunctionName, `Proto(m)`)
+	t.P(gen, `if err != nil {`)
+	t++
+	t.P(gen, `return err`)
+	t--
+	t.P(gen, `}`)
+	keys := []string{}
+
+	for _, fdInfo := range fields {
+		keys = append(keys, fmt.Sprintf("%q", fdInfo.fieldKey))
+	}
+
+	// Zero values are omitted by protojson or marshaled as empty strings, they are not valid segments
+	t.P(gen, `for _, k := range []string{`, strings.Join(keys, ", "), `} {`)
+	t++
+	t.P(gen, `if v, ok := pMap[k]; !ok || v == "" {`)
+	t++
+	t.P(gen, `return fmt.Errorf("%s of the id is not set", k)`)
+	t--
+	t.P(gen, `}`)
+	t--
+	t.P(gen, `}`)
+	t.P(gen, `id := fmt.Sprintf(`, fmt.Sprintf("%q", format), `, `, strings.Join(args, ", "), `)`)
+
+	// Segments containing a slash would not be parsed back
+	t.P(gen, `if _, err := `, mInfo.parseIDFunctionName, `(id); err != nil {`)
+	t++
+	t.P(gen, `return err`)
+	t--
+	t.P(gen, `}`)
+	t.P(gen, `rd.SetId(id)`)
+	t.P(gen, `return nil`)
+
+	t--
+
+	t.P(gen, `}`)
+
+	gen.P()
+
+	t.P(gen, `func `, mInfo.parseIDFunctionName, `(id string) (map[string]interface{}, error) {`)
+
+	t++
+
+	t.P(gen, `match := regexp.MustCompile(`, fmt.Sprintf("%q", pattern), `).FindStringSubmatch(id)`)
+	t.P(gen, `if match == nil {`)
+	t++
+	t.P(gen, `return nil, fmt.Errorf("invalid id %q, expected `, strings.ReplaceAll(mInfo.schema.IdFields, `"`, `'`), `", id)`)
+	t--
+	t.P(gen, `}`)
+
+	t.P(gen, `p := map[string]interface{}{}`)
+
+	for i, fdInfo := range fields {
+
+		if fdInfo.getFieldGoType() == "int" {
+
+			t.P(gen, `n`, i+1, `, err := strconv.Atoi(match[`, i+1, `])`)
+			t.P(gen, `if err != nil {`)
+			t++
+			t.P(gen, `return nil, fmt.Errorf("invalid `, fdInfo.fieldKey, ` %q in id: %w", match[`, i+1, `], err)`)
+			t--
+			t.P(gen, `}`)
+			t.P(gen, `p["`, fdInfo.fieldKey, `"] = n`, i+1)
+
+			continue
+
+		}
+
+		t.P(gen, `p["`, fdInfo.fieldKey, `"] = match[`, i+1, `]`)
+
+	}
+
+	t.P(gen, `return p, nil`)
+
+	t--
+
+	t.P(gen, `}`)
+
+}
+
+// writePatternIDFunctions writes the ID functions of a resource named after its
+// google.api.resource patterns, the ID is the resource name and its segments are
+// parsed with the first matching pattern
+func (mInfo *messageInfo) writePatternIDFunctions(t tab, gen *protogen.GeneratedFile, rd *resourceDescriptor) {
+
+	nameInfo := mInfo.nameStringField(rd.nameField)
+
+	if nameInfo == nil {
+		panic(fmt.Sprintf("name field %q of the google.api.resource of %s is not a field", rd.nameField, mInfo.value.Desc.FullName()))
+	}
+
+	t.P(gen, `func `, mInfo.setIDFunctionName, `(rd *schema.ResourceData, m proto.Message) error {`)
+
+	t++
+
+	t.P(gen, `pMap, err := `, mInfo.marshalFunctionName, `Proto(m)`)
+	t.P(gen, `if err != nil {`)
+	t++
+	t.P(gen, `return err`)
+	t--
+	t.P(gen, `}`)
+	t.P(gen, `name, _ := pMap["`, nameInfo.fieldKey, `"].(string)`)
+	t.P(gen, `p, err := `, mInfo.parseIDFunctionName, `(name)`)
+	t.P(gen, `if err != nil {`)
+	t++
+	t.P(gen, `return err`)
+	t--
+	t.P(gen, `}`)
+	t.P(gen, `rd.SetId(name)`)
+	t.P(gen, `for k, v := range p {`)
+	t++
+	t.P(gen, `if err := rd.Set(k, v); err != nil {`)
+	t++
+	t.P(gen, `return err`)
+	t--
+	t.P(gen, `}`)
+	t--
+	t.P(gen, `}`)
+	t.P(gen, `return nil`)
+
+	t--
+
+	t.P(gen, `}`)
+
+	gen.P()
+
+	t.P(gen, `func `, mInfo.parseIDFunctionName, `(id string) (map[string]interface{}, error) {`)
+
+	t++
+
+	for _, pattern := range rd.patterns {
+
+		expr, segments := patternRegexp(pattern)
+
+		t.P(gen, `if match := regexp.MustCompile(`, fmt.Sprintf("%q", expr), `).FindStringSubmatch(id); match != nil {`)
+
+		t++
+
+		t.P(gen, `return map[string]interface{}{`)
+
+		t++
+
+		t.P(gen, `"`, nameInfo.fieldKey, `": id,`)
+
+		matched := map[string]bool{}
+
+		for i, segment := range segments {
+			t.P(gen, `"`, mInfo.segmentKey(segment), `": match[`, i+1, `],`)
+			matched[segment] = true
+		}
+
+		// The computed segments of the other patterns are cleared
+		for _, attr := range mInfo.patternAttributes() {
+
+			if !matched[attr.Name] {
+				t.P(gen, `"`, attr.Name, `": "",`)
+			}
+
+		}
+
+		t--
+
+		t.P(gen, `}, nil`)
+
+		t--
+
+		t.P(gen, `}`)
+
+	}
+
+	t.P(gen, `return nil, fmt.Errorf("invalid id %q, expected `, strings.ReplaceAll(strings.Join(rd.patterns, " or "), `"`, `'`), `", id)`)
+
+	t--
+
+	t.P(gen, `}`)
+
+}
+
+// writeImporter sets the identifying attributes parsed from the imported ID
+func (mInfo *messageInfo) writeImporter(t tab, gen *protogen.GeneratedFile) {
+
+	t.P(gen, `Importer: &schema.ResourceImporter{`)
+
+	t++
+
+	t.P(gen, `StateContext: func(ctx context.Context, rd *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {`)
+
+	t++
+
+	t.P(gen, `p, err := `, mInfo.parseIDFunctionName, `(rd.Id())`)
+	t.P(gen, `if err != nil {`)
+	t++
+	t.P(gen, `return nil, err`)
+	t--
+	t.P(gen, `}`)
+	t.P(gen, `for k, v := range p {`)
+	t++
+	t.P(gen, `if err := rd.Set(k, v); err != nil {`)
+	t++
+	t.P(gen, `return nil, err`)
+	t--
+	t.P(gen, `}`)
+	t--
+	t.P(gen, `}`)
+	t.P(gen, `return []*schema.ResourceData{rd}, nil`)
 
-	okVar    string
-	valueVar string
+	t--
 
-	sourceVar string
-	source    string
-	selector  string
-}
+	t.P(gen, `},`)
 
-func newMessageInfo(fInfo *fileInfo, value *protogen.Message) *messageInfo {
+	t--
 
-	fullName := getDescriptorFullName(value.Desc, "")
-	varName := strcase.ToLowerCamel(fullName)
+	t.P(gen, `},`)
 
-	// panic(fmt.Sprintf("%s\n%s", value.Location.Path.String(), value.GoIdent.GoImportPath, value.GoIdent.GoName))
+}
 
-	mInfo := &messageInfo{
-		fInfo: fInfo,
+// stateUpgrades returns the previous definitions of the resource, terraform needs
+// one per version below the schema version
+func (mInfo *messageInfo) stateUpgrades() []*terraformpb.StateUpgrade {
 
-		value:  value,
-		schema: getMessageSchema(value.Desc),
+	upgrades := mInfo.schema.StateUpgrades
 
-		marshalFunctionName:   fmt.Sprintf("Marshal%s", fullName),
-		unmarshalFunctionName: fmt.Sprintf("Unmarshal%s", fullName),
-		schemaFunctionName:    fmt.Sprintf("New%sSchema", fullName),
+	for i, upgrade := range upgrades {
 
-		okVar:    fmt.Sprintf("ok%s", varName),
-		valueVar: fmt.Sprintf("value%s", varName),
+		if upgrade.Version != mInfo.schema.SchemaVersion-uint32(len(upgrades)-i) {
+			panic(fmt.Sprintf("state_upgrades of %s must be sequential versions ending at schema_version %d minus one", mInfo.value.Desc.FullName(), mInfo.schema.SchemaVersion))
+		}
 
-		sourceVar: "obj",
-		source:    "obj map[string]interface{}",
-		selector:  "obj[\"%s\"]",
 	}
 
-	return mInfo
+	return upgrades
+
 }
 
-func getMessageSchema(desc protoreflect.MessageDescriptor) *terraformpb.MessageSchema {
+func (mInfo *messageInfo) writeStateUpgraders(t tab, gen *protogen.GeneratedFile) {
 
-	opts, ok := desc.Options().(*descriptorpb.MessageOptions)
-	if !ok {
-		panic("Invalid message options")
+	upgrades := mInfo.stateUpgrades()
+
+	t.P(gen, `SchemaVersion: `, mInfo.schema.SchemaVersion, `,`)
+
+	if len(upgrades) == 0 {
+		return
 	}
 
-	if opts != nil && proto.HasExtension(opts, terraformpb.E_MessageSchema) {
+	t.P(gen, `StateUpgraders: []schema.StateUpgrader{`)
 
-		// https://stackoverflow.com/questions/28815214/how-to-set-get-protobufs-extension-field-in-go
-		return proto.GetExtension(opts, terraformpb.E_MessageSchema).(*terraformpb.MessageSchema)
+	t++
 
-	}
+	for _, upgrade := range upgrades {
+
+		previous := newMessageInfo(mInfo.fInfo, mInfo.fInfo.previousMessages[upgrade.Message])
+
+		t.P(gen, `{`)
+
+		t++
+
+		t.P(gen, `Version: `, upgrade.Version, `,`)
+		t.P(gen, `Type:    (&schema.Resource{Schema: `, previous.prefixWithPackage(previous.schemaFunctionName), `()}).CoreConfigSchema().ImpliedType(),`)
+		t.P(gen, `Upgrade: `, mInfo.stateUpgradeFunctionName, upgrade.Version, `,`)
+
+		t--
+
+		t.P(gen, `},`)
 
-	return &terraformpb.MessageSchema{
-		Generate:   false,
-		IsResource: false,
 	}
 
+	t--
+
+	t.P(gen, `},`)
+
 }
 
-func (mInfo *messageInfo) prefixWithPackage(suffix string) string {
+// writeStateUpgradeFunctions writes the upgraders of the states of each previous definition
+// into the next one, moving the values of the fields annotated with their previous name
+func (mInfo *messageInfo) writeStateUpgradeFunctions(t tab, gen *protogen.GeneratedFile) {
 
-	if mInfo.fInfo.file.GoImportPath != mInfo.value.GoIdent.GoImportPath {
+	upgrades := mInfo.stateUpgrades()
 
-		i := mInfo.fInfo.importNeeds.getPackageForMessage(mInfo.value)
+	for i, upgrade := range upgrades {
 
-		if len(i) == 0 {
-			i = mInfo.fInfo.importNeeds.getPackage(mInfo.value.GoIdent)
+		previous := mInfo.fInfo.previousMessages[upgrade.Message]
+		next := mInfo.value
+
+		if i+1 < len(upgrades) {
+			next = mInfo.fInfo.previousMessages[upgrades[i+1].Message]
 		}
 
-		suffix = fmt.Sprintf("%s%s", i, suffix)
+		if i > 0 {
+			gen.P()
+		}
+
+		t.P(gen, `func `, mInfo.stateUpgradeFunctionName, upgrade.Version, `(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {`)
+
+		t++
+
+		t.P(gen, `if rawState == nil {`)
+		t++
+		t.P(gen, `return rawState, nil`)
+		t--
+		t.P(gen, `}`)
+
+		for _, field := range next.Fields {
+
+			fdInfo := newFieldInfo(mInfo.fInfo, field)
+
+			if fdInfo.schema.Ignore || len(fdInfo.schema.PreviousName) == 0 {
+				continue
+			}
+
+			if isOneofMember(field) && !newOneOfInfo(mInfo.fInfo, field.Oneof).schema.Flatten {
+				panic(fmt.Sprintf("field %s has a previous_name but is not an attribute of the resource", field.Desc.FullName()))
+			}
+
+			writeStateMove(t, gen, previousStatePath(previous, fdInfo.schema.PreviousName), fdInfo.fieldKey)
+
+		}
+
+		t.P(gen, `return rawState, nil`)
+
+		t--
+
+		t.P(gen, `}`)
+
 	}
 
-	return suffix
 }
 
-func (mInfo *messageInfo) makeSelector(fieldKey string) string {
-	return fmt.Sprintf(mInfo.selector, fieldKey)
-}
+// previousStatePath returns the terraform keys of the field path in the previous message,
+// every key but the last one is a block
+func previousStatePath(previous *protogen.Message, previousName string) []string {
+
+	keys := []string{}
+	msg := previous
+
+	segments := strings.Split(previousName, ".")
+
+	for i, segment := range segments {
+
+		var field *protogen.Field
+
+		if msg != nil {
+			field = newMessageInfo(nil, msg).fieldByProtoName(segment)
+		}
+
+		if field == nil || getFieldSchema(field.Desc).Ignore {
+			panic(fmt.Sprintf("previous_name %q is not a field of %s", previousName, previous.Desc.FullName()))
+		}
+
+		if isOneofMember(field) {
+
+			if oInfo := newOneOfInfo(nil, field.Oneof); !oInfo.schema.Flatten {
+				keys = append(keys, oInfo.oneOfKey)
+			}
+
+		}
+
+		keys = append(keys, newFieldInfo(nil, field).fieldKey)
+
+		msg = nil
+
+		if i+1 < len(segments) {
+
+			if field.Message == nil || field.Desc.IsList() || field.Desc.IsMap() || isWellKnownMessage(field.Message) {
+				panic(fmt.Sprintf("previous_name %q of %s goes through %s which is not a nested block", previousName, previous.Desc.FullName(), segment))
+			}
+
+			msg = field.Message
+
+		}
+
+	}
+
+	return keys
 
-func (mInfo *messageInfo) makeMapIndex(fieldKey string) string {
-	return fmt.Sprintf(`p["%s"]`, fieldKey)
 }
 
-func (mInfo *messageInfo) writeSchemaFunction(t tab, gen *protogen.GeneratedFile) {
+// writeStateMove moves the raw state value at the path to the attribute of the resource,
+// the blocks left empty are removed
+func writeStateMove(t tab, gen *protogen.GeneratedFile, path []string, key string) {
 
-	t.P(gen, `func `, mInfo.schemaFunctionName, `() map[string]*schema.Schema {`)
+	container := "rawState"
 
+	containers := []string{container}
+
+	for i, block := range path[:len(path)-1] {
+
+		t.P(gen, `if b`, i+1, `, ok := `, container, `["`, block, `"].([]interface{}); ok && len(b`, i+1, `) > 0 {`)
+
+		t++
+
+		t.P(gen, `if m`, i+1, `, ok := b`, i+1, `[0].(map[string]interface{}); ok {`)
+
+		t++
+
+		container = fmt.Sprintf("m%d", i+1)
+
+		containers = append(containers, container)
+
+	}
+
+	last := path[len(path)-1]
+
+	t.P(gen, `if v, ok := `, container, `["`, last, `"]; ok {`)
 	t++
+	t.P(gen, `delete(`, container, `, "`, last, `")`)
+	t.P(gen, `rawState["`, key, `"] = v`)
+	t--
+	t.P(gen, `}`)
 
-	t.P(gen, `return map[string]*schema.Schema{`)
+	for i := len(path) - 2; i >= 0; i-- {
+
+		t.P(gen, `if len(`, containers[i+1], `) == 0 {`)
+		t++
+		t.P(gen, `delete(`, containers[i], `, "`, path[i], `")`)
+		t--
+		t.P(gen, `}`)
+
+		t--
+
+		t.P(gen, `}`)
+
+		t--
+
+		t.P(gen, `}`)
+
+	}
+
+}
+
+// writeUpdateMaskFunction lists the proto paths of the attributes changed in the resource data
+func (mInfo *messageInfo) writeUpdateMaskFunction(t tab, gen *protogen.GeneratedFile) {
+
+	t.P(gen, `func `, mInfo.updateMaskFunctionName, `(rd *schema.ResourceData) *fieldmaskpb.FieldMask {`)
 
 	t++
 
+	t.P(gen, `paths := []string{}`)
+
+	mInfo.writeUpdateMaskPaths(t, gen, "", "")
+
+	t.P(gen, `return &fieldmaskpb.FieldMask{Paths: paths}`)
+
+	t--
+
+	t.P(gen, `}`)
+
+}
+
+func (mInfo *messageInfo) writeUpdateMaskPaths(t tab, gen *protogen.GeneratedFile, keyPrefix, pathPrefix string) {
+
 	for _, field := range mInfo.value.Fields {
 
-		if field.Oneof == nil {
+		fdInfo := newFieldInfo(mInfo.fInfo, field)
+
+		if fdInfo.schema.Ignore {
+			continue
+		}
+
+		if !isOneofMember(field) || newOneOfInfo(mInfo.fInfo, field.Oneof).schema.Flatten {
+			fdInfo.writeUpdateMaskPath(t, gen, keyPrefix+fdInfo.fieldKey, pathPrefix+fdInfo.protoKey)
+		}
+
+	}
+
+	// Oneof fields live in the wrapper block but belong to the message in the proto paths
+	for _, oneOf := range realOneofs(mInfo.value) {
+
+		oInfo := newOneOfInfo(mInfo.fInfo, oneOf)
+
+		if oInfo.schema.Flatten {
+			continue
+		}
+
+		t.P(gen, `if rd.HasChange("`, keyPrefix+oInfo.oneOfKey, `") {`)
+
+		t++
+
+		for _, field := range oInfo.fields() {
 
 			fdInfo := newFieldInfo(mInfo.fInfo, field)
 
-			fdInfo.writeSchema(t, gen)
+			fdInfo.writeUpdateMaskPath(t, gen, keyPrefix+oInfo.oneOfKey+".0."+fdInfo.fieldKey, pathPrefix+fdInfo.protoKey)
 
 		}
 
+		t--
+
+		t.P(gen, `}`)
+
 	}
 
-	for _, oneOf := range mInfo.value.Oneofs {
+}
 
-		oInfo := newOneOfInfo(mInfo.fInfo, oneOf)
+// stateAttributeNames returns the attributes read back into the state, write-only
+// attributes keep their configured values
+func (mInfo *messageInfo) stateAttributeNames() []string {
 
-		oInfo.writeSchema(t, gen)
+	names := []string{}
+
+	for _, field := range mInfo.value.Fields {
+
+		fdInfo := newFieldInfo(mInfo.fInfo, field)
+
+		if fdInfo.schema.Ignore || fdInfo.schema.WriteOnly {
+			continue
+		}
+
+		if !isOneofMember(field) || newOneOfInfo(mInfo.fInfo, field.Oneof).schema.Flatten {
+			names = append(names, fdInfo.fieldKey)
+		}
 
 	}
 
-	t--
+	for _, oneOf := range realOneofs(mInfo.value) {
 
-	t.P(gen, `}`)
+		oInfo := newOneOfInfo(mInfo.fInfo, oneOf)
 
-	t--
+		if !oInfo.schema.Flatten {
+			names = append(names, oInfo.oneOfKey)
+		}
 
-	t.P(gen, `}`)
+	}
+
+	return names
 
 }
 
 func (mInfo *messageInfo) writeMarshaler(t tab, gen *protogen.GeneratedFile) {
 
-	t.P(gen, `func `, mInfo.marshalFunctionName, `(obj map[string]interface{}) (map[string]interface{}, error) {`)
+	if mInfo.isRecursive() {
+
+		mInfo.writeDepthWrapper(t, gen, mInfo.marshalFunctionName, `obj map[string]interface{}`, []string{`obj`}, `(map[string]interface{}, error)`)
+		gen.P()
+
+		t.P(gen, `func `, mInfo.marshalFunctionName, `Depth(obj map[string]interface{}, depth int) (map[string]interface{}, error) {`)
+
+	} else {
+		t.P(gen, `func `, mInfo.marshalFunctionName, `(obj map[string]interface{}) (map[string]interface{}, error) {`)
+	}
 
 	t++
 
@@ -152,7 +1203,7 @@ func (mInfo *messageInfo) writeMarshaler(t tab, gen *protogen.GeneratedFile) {
 
 	for _, field := range mInfo.value.Fields {
 
-		if field.Oneof == nil {
+		if !isOneofMember(field) {
 
 			fdInfo := newFieldInfo(mInfo.fInfo, field)
 
@@ -162,7 +1213,7 @@ func (mInfo *messageInfo) writeMarshaler(t tab, gen *protogen.GeneratedFile) {
 
 	}
 
-	for _, oneOf := range mInfo.value.Oneofs {
+	for _, oneOf := range realOneofs(mInfo.value) {
 
 		oInfo := newOneOfInfo(mInfo.fInfo, oneOf)
 
@@ -192,7 +1243,15 @@ func (mInfo *messageInfo) writeMarshaler(t tab, gen *protogen.GeneratedFile) {
 	t--
 	t.P(gen, `}`)
 
-	t.P(gen, `err = json.Unmarshal(b, &obj)`)
+	// Numbers are kept as json.Number to not lose precision
+	if messageHasNumbers(mInfo.value) {
+		t.P(gen, `d := json.NewDecoder(bytes.NewReader(b))`)
+		t.P(gen, `d.UseNumber()`)
+		t.P(gen, `err = d.Decode(&obj)`)
+	} else {
+		t.P(gen, `err = json.Unmarshal(b, &obj)`)
+	}
+
 	t.P(gen, `if err != nil {`)
 	t++
 	t.P(gen, `return nil, err`)
@@ -209,17 +1268,44 @@ func (mInfo *messageInfo) writeMarshaler(t tab, gen *protogen.GeneratedFile) {
 
 	if mInfo.schema.IsResource {
 
-		t.P(gen, `func `, mInfo.marshalFunctionName, `ResourceData(m proto.Message, rd *schema.ResourceData) {`)
+		t.P(gen, `func `, mInfo.marshalFunctionName, `ResourceData(m proto.Message, rd *schema.ResourceData) diag.Diagnostics {`)
+
+		t++
 
+		t.P(gen, `pMap, err := `, mInfo.marshalFunctionName, `Proto(m)`)
+		t.P(gen, `if err != nil {`)
 		t++
+		t.P(gen, `return diag.FromErr(err)`)
+		t--
+		t.P(gen, `}`)
+
+		t.P(gen, `var diags diag.Diagnostics`)
+		names := []string{}
+
+		for _, name := range mInfo.stateAttributeNames() {
+			names = append(names, fmt.Sprintf("%q", name))
+		}
 
-		t.P(gen, `pMap := `, mInfo.marshalFunctionName, `Proto(m)`)
-		t.P(gen, `for k, v := range pMap {`)
+		// The values the API cleared are missing from the map and cleared in the state
+		t.P(gen, `for _, k := range []string{`, strings.Join(names, `, `), `} {`)
 		t++
-		t.P(gen, `rd.Set(k, v)`)
+		t.P(gen, `if err := rd.Set(k, pMap[k]); err != nil {`)
+		t++
+		t.P(gen, `diags = append(diags, diag.Diagnostic{`)
+		t++
+		t.P(gen, `Severity:      diag.Error,`)
+		t.P(gen, `Summary:       "Unable to set the attribute " + k,`)
+		t.P(gen, `Detail:        err.Error(),`)
+		t.P(gen, `AttributePath: cty.GetAttrPath(k),`)
+		t--
+		t.P(gen, `})`)
+		t--
+		t.P(gen, `}`)
 		t--
 		t.P(gen, `}`)
 
+		t.P(gen, `return diags`)
+
 		t--
 
 		t.P(gen, `}`)
@@ -230,9 +1316,44 @@ func (mInfo *messageInfo) writeMarshaler(t tab, gen *protogen.GeneratedFile) {
 
 }
 
+// usesConfig reports if unmarshaling the message reads the configuration of its block
+func (mInfo *messageInfo) usesConfig() bool {
+
+	for _, field := range mInfo.value.Fields {
+
+		if newFieldInfo(mInfo.fInfo, field).usesConfig() {
+			return true
+		}
+
+	}
+
+	return false
+
+}
+
 func (mInfo *messageInfo) writeUnmarshaler(t tab, gen *protogen.GeneratedFile) {
 
+	// Without configuration the keys of the object are the set attributes
 	t.P(gen, `func `, mInfo.unmarshalFunctionName, `(`, mInfo.source, `) (map[string]interface{}, error) {`)
+	t++
+	t.P(gen, `return `, mInfo.unmarshalConfigFunctionName, `(`, mInfo.sourceVar, `, cty.NilVal)`)
+	t--
+	t.P(gen, `}`)
+
+	gen.P()
+
+	params := fmt.Sprintf("%s, %s cty.Value", mInfo.source, mInfo.configVar)
+
+	if mInfo.isRecursive() {
+
+		mInfo.writeDepthWrapper(t, gen, mInfo.unmarshalConfigFunctionName, params, []string{mInfo.sourceVar, mInfo.configVar}, `(map[string]interface{}, error)`)
+		gen.P()
+
+		t.P(gen, `func `, mInfo.unmarshalConfigFunctionName, `Depth(`, params, `, depth int) (map[string]interface{}, error) {`)
+
+	} else {
+		t.P(gen, `func `, mInfo.unmarshalConfigFunctionName, `(`, params, `) (map[string]interface{}, error) {`)
+	}
 
 	t++
 
@@ -240,7 +1361,7 @@ func (mInfo *messageInfo) writeUnmarshaler(t tab, gen *protogen.GeneratedFile) {
 
 	for _, field := range mInfo.value.Fields {
 
-		if field.Oneof == nil {
+		if !isOneofMember(field) {
 
 			fdInfo := newFieldInfo(mInfo.fInfo, field)
 
@@ -250,7 +1371,7 @@ func (mInfo *messageInfo) writeUnmarshaler(t tab, gen *protogen.GeneratedFile) {
 
 	}
 
-	for _, oneOf := range mInfo.value.Oneofs {
+	for _, oneOf := range realOneofs(mInfo.value) {
 
 		oInfo := newOneOfInfo(mInfo.fInfo, oneOf)
 
@@ -311,11 +1432,26 @@ func (mInfo *messageInfo) writeUnmarshaler(t tab, gen *protogen.GeneratedFile) {
 
 		t++
 
+		if mInfo.isRecursive() {
+			t.P(gen, `depth := `, mInfo.schema.MaxDepth)
+		}
+
+		// The configuration is not known outside of the plan and apply, only the
+		// non-zero values are then known to be set
+		if mInfo.usesConfig() {
+			t.P(gen, mInfo.configVar, ` := rd.GetRawConfig()`)
+			t.P(gen, `if `, mInfo.configVar, `.IsNull() {`)
+			t++
+			t.P(gen, mInfo.configVar, ` = cty.DynamicVal`)
+			t--
+			t.P(gen, `}`)
+		}
+
 		t.P(gen, `p := map[string]interface{}{}`)
 
 		for _, field := range mInfo.value.Fields {
 
-			if field.Oneof == nil {
+			if !isOneofMember(field) {
 
 				fdInfo := newFieldInfo(mInfo.fInfo, field)
 
@@ -325,7 +1461,7 @@ func (mInfo *messageInfo) writeUnmarshaler(t tab, gen *protogen.GeneratedFile) {
 
 		}
 
-		for _, oneOf := range mInfo.value.Oneofs {
+		for _, oneOf := range realOneofs(mInfo.value) {
 
 			oInfo := newOneOfInfo(mInfo.fInfo, oneOf)
 
@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/iancoleman/strcase"
 	terraformpb "github.com/protomesh/protoc-gen-terraform/proto/terraform"
@@ -21,6 +22,7 @@ type oneOfInfo struct {
 
 	okVar    string
 	valueVar string
+	setVar   string
 
 	marshalFunctionName   string
 	unmarshalFunctionName string
@@ -42,6 +44,7 @@ func newOneOfInfo(fInfo *fileInfo, value *protogen.Oneof) *oneOfInfo {
 
 		okVar:    fmt.Sprintf("ok%s", varName),
 		valueVar: fmt.Sprintf("value%s", varName),
+		setVar:   fmt.Sprintf("set%s", varName),
 
 		marshalFunctionName:   fmt.Sprintf("Marshal%s", fullName),
 		unmarshalFunctionName: fmt.Sprintf("Unmarshal%s", fullName),
@@ -67,13 +70,20 @@ func getOneofSchema(desc protoreflect.OneofDescriptor) *terraformpb.OneofSchema
 
 func (oInfo *oneOfInfo) writeSchema(t tab, gen *protogen.GeneratedFile) {
 
+	if oInfo.schema.Flatten {
+		oInfo.writeFlattenedSchema(t, gen)
+		return
+	}
+
 	t.P(gen, `"`, oInfo.oneOfKey, `": {`)
 
 	t++
 
-	t.P(gen, `Type: schema.TypeList,`)
-	t.P(gen, `MaxItems: 1,`)
-	t.P(gen, `Optional: true,`)
+	attr := oInfo.describeAttribute()
+
+	attr.writeSchemaType(t, gen)
+	attr.writeSchemaBehavior(t, gen)
+
 	t.P(gen, `Elem: &schema.Resource{`)
 
 	t++
@@ -82,7 +92,7 @@ func (oInfo *oneOfInfo) writeSchema(t tab, gen *protogen.GeneratedFile) {
 
 	t++
 
-	for _, field := range oInfo.value.Fields {
+	for _, field := range oInfo.fields() {
 
 		fdInfo := newFieldInfo(oInfo.fInfo, field)
 
@@ -104,16 +114,99 @@ func (oInfo *oneOfInfo) writeSchema(t tab, gen *protogen.GeneratedFile) {
 
 }
 
+// fields returns the oneof fields mapped into terraform
+func (oInfo *oneOfInfo) fields() []*protogen.Field {
+
+	fields := []*protogen.Field{}
+
+	for _, field := range oInfo.value.Fields {
+
+		if !getFieldSchema(field.Desc).Ignore {
+			fields = append(fields, field)
+		}
+
+	}
+
+	return fields
+
+}
+
+func (oInfo *oneOfInfo) fieldKeys() []string {
+
+	keys := []string{}
+
+	for _, field := range oInfo.fields() {
+		keys = append(keys, newFieldInfo(oInfo.fInfo, field).fieldKey)
+	}
+
+	return keys
+
+}
+
+func (oInfo *oneOfInfo) protoKeys() []string {
+
+	keys := []string{}
+
+	for _, field := range oInfo.fields() {
+		keys = append(keys, newFieldInfo(oInfo.fInfo, field).protoKey)
+	}
+
+	return keys
+
+}
+
+func (oInfo *oneOfInfo) writeFlattenedSchema(t tab, gen *protogen.GeneratedFile) {
+
+	// Schema constraints are resolved from the resource root, so they can
+	// only be emitted when the parent message is the resource itself, the
+	// other oneofs are checked when unmarshaling.
+	keys := oInfo.fieldKeys()
+
+	for _, field := range oInfo.fields() {
+
+		fdInfo := newFieldInfo(oInfo.fInfo, field)
+
+		if oInfo.isConstrained() && oInfo.schema.Required {
+			fdInfo.exactlyOneOf = keys
+		} else if oInfo.isConstrained() {
+			for _, key := range keys {
+				if key != fdInfo.fieldKey {
+					fdInfo.conflictsWith = append(fdInfo.conflictsWith, key)
+				}
+			}
+		}
+
+		fdInfo.writeSchema(t, gen)
+
+	}
+
+}
+
 func (oInfo *oneOfInfo) makeSelector(fieldKey string) string {
 	return "oneOfVal"
 }
 
+func (oInfo *oneOfInfo) getConfigVar() string {
+	return "oneOfConfig"
+}
+
 func (oInfo *oneOfInfo) makeMapIndex(fieldKey string) string {
 	return fmt.Sprintf(`p["%s"].([]interface{})[0].(map[string]interface{})["%s"]`, oInfo.oneOfKey, fieldKey)
 }
 
 func (oInfo *oneOfInfo) writeUnmarshal(t tab, gen *protogen.GeneratedFile, sm selectorMaker) {
 
+	if oInfo.schema.Flatten {
+
+		for _, f := range oInfo.fields() {
+			newFieldInfo(oInfo.fInfo, f).writeUnmarshal(t, gen, sm)
+		}
+
+		oInfo.writeSetCheck(t, gen)
+
+		return
+	}
+
 	selector := sm.makeSelector(oInfo.oneOfKey)
 
 	t.P(gen, `if `, oInfo.valueVar, `, `, oInfo.okVar, ` := `, selector, `.([]interface{}); `, oInfo.okVar, ` && len(`, oInfo.valueVar, `) > 0 {`)
@@ -122,7 +215,11 @@ func (oInfo *oneOfInfo) writeUnmarshal(t tab, gen *protogen.GeneratedFile, sm se
 
 	t.P(gen, `o := `, oInfo.valueVar, `[0].(map[string]interface{})`)
 
-	for _, f := range oInfo.value.Fields {
+	if oInfo.usesConfig() {
+		writeBlockConfig(t, gen, sm.getConfigVar(), oInfo.oneOfKey, oInfo.getConfigVar(), `0`)
+	}
+
+	for _, f := range oInfo.fields() {
 
 		oneOfFInfo := newFieldInfo(oInfo.fInfo, f)
 
@@ -139,19 +236,90 @@ func (oInfo *oneOfInfo) writeUnmarshal(t tab, gen *protogen.GeneratedFile, sm se
 
 	t.P(gen, `}`)
 
+	oInfo.writeSetCheck(t, gen)
+
+}
+
+// usesConfig reports if unmarshaling a field of the oneof reads the configuration of its block
+func (oInfo *oneOfInfo) usesConfig() bool {
+
+	for _, field := range oInfo.fields() {
+
+		if newFieldInfo(oInfo.fInfo, field).usesConfig() {
+			return true
+		}
+
+	}
+
+	return false
+
+}
+
+// isConstrained reports if the schema already lets a single field of the oneof be set,
+// the constraints are resolved from the resource root so they only exist on its flattened oneofs
+func (oInfo *oneOfInfo) isConstrained() bool {
+	return oInfo.schema.Flatten && getMessageSchema(oInfo.value.Parent.Desc).IsResource
+}
+
+// writeSetCheck reports a missing field of a required oneof and the fields set together
+// when the schema does not prevent it, protojson would fail with a less helpful error
+func (oInfo *oneOfInfo) writeSetCheck(t tab, gen *protogen.GeneratedFile) {
+
+	if !oInfo.schema.Required && oInfo.isConstrained() {
+		return
+	}
+
+	keys := oInfo.protoKeys()
+
+	t.P(gen, oInfo.setVar, ` := 0`)
+	t.P(gen, `for _, k := range []string{"`, strings.Join(keys, `","`), `"} {`)
+	t++
+	t.P(gen, `if _, ok := p[k]; ok {`)
+	t++
+	t.P(gen, oInfo.setVar, `++`)
+	t--
+	t.P(gen, `}`)
+	t--
+	t.P(gen, `}`)
+
+	if oInfo.schema.Required {
+		t.P(gen, `if `, oInfo.setVar, ` == 0 {`)
+		t++
+		t.P(gen, "return nil, fmt.Errorf(`one of \"", strings.Join(keys, `", "`), "\" must be set for \"", oInfo.oneOfKey, "\"`)")
+		t--
+		t.P(gen, `}`)
+	}
+
+	if !oInfo.isConstrained() {
+		t.P(gen, `if `, oInfo.setVar, ` > 1 {`)
+		t++
+		t.P(gen, "return nil, fmt.Errorf(`only one of \"", strings.Join(keys, `", "`), "\" can be set for \"", oInfo.oneOfKey, "\"`)")
+		t--
+		t.P(gen, `}`)
+	}
+
 }
 
 func (oInfo *oneOfInfo) writeMarshal(t tab, gen *protogen.GeneratedFile, mi mapIndexMaker) {
 
+	if oInfo.schema.Flatten {
+
+		for _, f := range oInfo.fields() {
+			newFieldInfo(oInfo.fInfo, f).writeMarshal(t, gen, mi)
+		}
+
+		return
+	}
+
 	selector := mi.makeMapIndex(oInfo.oneOfKey)
 
 	t.P(gen, selector, ` = []interface{}{}`)
 
-	for _, f := range oInfo.value.Fields {
+	for _, f := range oInfo.fields() {
 
 		oneOfFInfo := newFieldInfo(oInfo.fInfo, f)
 
-		t.P(gen, `if _, ok := obj["`, oneOfFInfo.fieldKey, `"]; ok {`)
+		t.P(gen, `if _, ok := obj["`, oneOfFInfo.protoKey, `"]; ok {`)
 		t++
 		t.P(gen, selector, ` = append(`, selector, `.([]interface{}), map[string]interface{}{})`)
 		oneOfFInfo.writeMarshal(t, gen, oInfo)
@@ -0,0 +1,170 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: terraform/enum_value_schema.proto
+
+package terraformpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EnumValueSchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of this value in terraform (takes precedence over the enum naming options)
+	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	// Deprecation notice for this value
+	Deprecation string `protobuf:"bytes,2,opt,name=deprecation,proto3" json:"deprecation,omitempty"`
+	// Hide this value from the accepted values and the docs
+	Hide bool `protobuf:"varint,3,opt,name=hide,proto3" json:"hide,omitempty"`
+}
+
+func (x *EnumValueSchema) Reset() {
+	*x = EnumValueSchema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_terraform_enum_value_schema_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnumValueSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumValueSchema) ProtoMessage() {}
+
+func (x *EnumValueSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_terraform_enum_value_schema_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumValueSchema.ProtoReflect.Descriptor instead.
+func (*EnumValueSchema) Descriptor() ([]byte, []int) {
+	return file_terraform_enum_value_schema_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EnumValueSchema) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *EnumValueSchema) GetDeprecation() string {
+	if x != nil {
+		return x.Deprecation
+	}
+	return ""
+}
+
+func (x *EnumValueSchema) GetHide() bool {
+	if x != nil {
+		return x.Hide
+	}
+	return false
+}
+
+var File_terraform_enum_value_schema_proto protoreflect.FileDescriptor
+
+var file_terraform_enum_value_schema_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x65, 0x6e, 0x75, 0x6d,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x74,
+	0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0x5d, 0x0a, 0x0f, 0x45, 0x6e, 0x75, 0x6d,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x68, 0x69, 0x64, 0x65, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x74, 0x65, 0x72, 0x72, 0x61,
+	0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61,
+	0x66, 0x6f, 0x72, 0x6d, 0x3b, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_terraform_enum_value_schema_proto_rawDescOnce sync.Once
+	file_terraform_enum_value_schema_proto_rawDescData = file_terraform_enum_value_schema_proto_rawDesc
+)
+
+func file_terraform_enum_value_schema_proto_rawDescGZIP() []byte {
+	file_terraform_enum_value_schema_proto_rawDescOnce.Do(func() {
+		file_terraform_enum_value_schema_proto_rawDescData = protoimpl.X.CompressGZIP(file_terraform_enum_value_schema_proto_rawDescData)
+	})
+	return file_terraform_enum_value_schema_proto_rawDescData
+}
+
+var file_terraform_enum_value_schema_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_terraform_enum_value_schema_proto_goTypes = []interface{}{
+	(*EnumValueSchema)(nil), // 0: protomesh.terraform.EnumValueSchema
+}
+var file_terraform_enum_value_schema_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_terraform_enum_value_schema_proto_init() }
+func file_terraform_enum_value_schema_proto_init() {
+	if File_terraform_enum_value_schema_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_terraform_enum_value_schema_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnumValueSchema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_terraform_enum_value_schema_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_terraform_enum_value_schema_proto_goTypes,
+		DependencyIndexes: file_terraform_enum_value_schema_proto_depIdxs,
+		MessageInfos:      file_terraform_enum_value_schema_proto_msgTypes,
+	}.Build()
+	File_terraform_enum_value_schema_proto = out.File
+	file_terraform_enum_value_schema_proto_rawDesc = nil
+	file_terraform_enum_value_schema_proto_goTypes = nil
+	file_terraform_enum_value_schema_proto_depIdxs = nil
+}
@@ -21,6 +21,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type BytesEncoding int32
+
+const (
+	// Same as BYTES_ENCODING_BASE64
+	BytesEncoding_BYTES_ENCODING_UNSPECIFIED BytesEncoding = 0
+	// Bytes are base64 strings in terraform
+	BytesEncoding_BYTES_ENCODING_BASE64 BytesEncoding = 1
+	// Bytes are raw UTF-8 strings in terraform
+	BytesEncoding_BYTES_ENCODING_RAW BytesEncoding = 2
+)
+
+// Enum value maps for BytesEncoding.
+var (
+	BytesEncoding_name = map[int32]string{
+		0: "BYTES_ENCODING_UNSPECIFIED",
+		1: "BYTES_ENCODING_BASE64",
+		2: "BYTES_ENCODING_RAW",
+	}
+	BytesEncoding_value = map[string]int32{
+		"BYTES_ENCODING_UNSPECIFIED": 0,
+		"BYTES_ENCODING_BASE64":      1,
+		"BYTES_ENCODING_RAW":         2,
+	}
+)
+
+func (x BytesEncoding) Enum() *BytesEncoding {
+	p := new(BytesEncoding)
+	*p = x
+	return p
+}
+
+func (x BytesEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BytesEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_terraform_field_schema_proto_enumTypes[0].Descriptor()
+}
+
+func (BytesEncoding) Type() protoreflect.EnumType {
+	return &file_terraform_field_schema_proto_enumTypes[0]
+}
+
+func (x BytesEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BytesEncoding.Descriptor instead.
+func (BytesEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_terraform_field_schema_proto_rawDescGZIP(), []int{0}
+}
+
 type FieldSchema struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -34,6 +86,44 @@ type FieldSchema struct {
 	DefaultValue *structpb.Value `protobuf:"bytes,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
 	// Is this field computed
 	Computed bool `protobuf:"varint,4,opt,name=computed,proto3" json:"computed,omitempty"`
+	// Deprecation message for this field (defaults to a generic text when
+	// the field has the deprecated option)
+	DeprecationMessage string `protobuf:"bytes,5,opt,name=deprecation_message,json=deprecationMessage,proto3" json:"deprecation_message,omitempty"`
+	// Attribute name in terraform (takes precedence over the file naming strategy)
+	Name string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	// Do not map this field into terraform
+	Ignore bool `protobuf:"varint,7,opt,name=ignore,proto3" json:"ignore,omitempty"`
+	// Name of the key attribute in the blocks of a map of messages (defaults to key)
+	MapKey string `protobuf:"bytes,8,opt,name=map_key,json=mapKey,proto3" json:"map_key,omitempty"`
+	// Attributes of the block hashed to identify the elements of a TypeSet
+	// (defaults to hashing the whole block)
+	HashKeys []string `protobuf:"bytes,9,rep,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
+	// Bounds of the number of elements of repeated fields and maps of messages
+	MinItems uint32 `protobuf:"varint,10,opt,name=min_items,json=minItems,proto3" json:"min_items,omitempty"`
+	MaxItems uint32 `protobuf:"varint,11,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"`
+	// Represent 64-bit integers as strings in terraform, needed for uint64 and
+	// fixed64 values beyond the int64 range
+	Int64AsString bool `protobuf:"varint,12,opt,name=int64_as_string,json=int64AsString,proto3" json:"int64_as_string,omitempty"`
+	// How bytes fields are represented in terraform
+	BytesEncoding BytesEncoding `protobuf:"varint,13,opt,name=bytes_encoding,json=bytesEncoding,proto3,enum=protomesh.terraform.BytesEncoding" json:"bytes_encoding,omitempty"`
+	// Ignore differences between values that are equal once rounded to a
+	// float32, as they are read back from the API
+	SuppressFloat32Rounding bool `protobuf:"varint,14,opt,name=suppress_float32_rounding,json=suppressFloat32Rounding,proto3" json:"suppress_float32_rounding,omitempty"`
+	// Mark the attribute as sensitive, terraform hides it in the plans and
+	// outputs. It is still read back into the state, set write_only as well
+	// when the API redacts the value
+	Sensitive bool `protobuf:"varint,15,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	// The value is only sent to the API and never read back into the state
+	// of the resource, it keeps its configured value. Only top level attributes
+	// of the resource, flattened oneofs included, can be write-only
+	WriteOnly bool `protobuf:"varint,16,opt,name=write_only,json=writeOnly,proto3" json:"write_only,omitempty"`
+	// Proto name of the field in the previous definition of the resource, its
+	// state value is moved to this attribute when upgrading the state. Fields
+	// moved out of nested messages use a dotted path (config.size), blocks left
+	// empty are removed. Only top level fields of the resource can be upgraded
+	PreviousName string `protobuf:"bytes,17,opt,name=previous_name,json=previousName,proto3" json:"previous_name,omitempty"`
+	// Changing the attribute replaces the resource
+	ForceNew bool `protobuf:"varint,18,opt,name=force_new,json=forceNew,proto3" json:"force_new,omitempty"`
 }
 
 func (x *FieldSchema) Reset() {
@@ -96,6 +186,104 @@ func (x *FieldSchema) GetComputed() bool {
 	return false
 }
 
+func (x *FieldSchema) GetDeprecationMessage() string {
+	if x != nil {
+		return x.DeprecationMessage
+	}
+	return ""
+}
+
+func (x *FieldSchema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FieldSchema) GetIgnore() bool {
+	if x != nil {
+		return x.Ignore
+	}
+	return false
+}
+
+func (x *FieldSchema) GetMapKey() string {
+	if x != nil {
+		return x.MapKey
+	}
+	return ""
+}
+
+func (x *FieldSchema) GetHashKeys() []string {
+	if x != nil {
+		return x.HashKeys
+	}
+	return nil
+}
+
+func (x *FieldSchema) GetMinItems() uint32 {
+	if x != nil {
+		return x.MinItems
+	}
+	return 0
+}
+
+func (x *FieldSchema) GetMaxItems() uint32 {
+	if x != nil {
+		return x.MaxItems
+	}
+	return 0
+}
+
+func (x *FieldSchema) GetInt64AsString() bool {
+	if x != nil {
+		return x.Int64AsString
+	}
+	return false
+}
+
+func (x *FieldSchema) GetBytesEncoding() BytesEncoding {
+	if x != nil {
+		return x.BytesEncoding
+	}
+	return BytesEncoding_BYTES_ENCODING_UNSPECIFIED
+}
+
+func (x *FieldSchema) GetSuppressFloat32Rounding() bool {
+	if x != nil {
+		return x.SuppressFloat32Rounding
+	}
+	return false
+}
+
+func (x *FieldSchema) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+func (x *FieldSchema) GetWriteOnly() bool {
+	if x != nil {
+		return x.WriteOnly
+	}
+	return false
+}
+
+func (x *FieldSchema) GetPreviousName() string {
+	if x != nil {
+		return x.PreviousName
+	}
+	return ""
+}
+
+func (x *FieldSchema) GetForceNew() bool {
+	if x != nil {
+		return x.ForceNew
+	}
+	return false
+}
+
 var File_terraform_field_schema_proto protoreflect.FileDescriptor
 
 var file_terraform_field_schema_proto_rawDesc = []byte{
@@ -104,7 +292,7 @@ var file_terraform_field_schema_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66,
 	0x6f, 0x72, 0x6d, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xa2, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x6f, 0x22, 0x9d, 0x05, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d,
 	0x61, 0x12, 0x1e, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x73, 0x65, 0x74,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x54, 0x79, 0x70, 0x65, 0x53, 0x65,
 	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20,
@@ -114,12 +302,50 @@ var file_terraform_field_schema_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x64, 0x65,
 	0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
 	0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f,
-	0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66,
-	0x6f, 0x72, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66,
-	0x6f, 0x72, 0x6d, 0x3b, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x70, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69,
+	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09,
+	0x68, 0x61, 0x73, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x68, 0x61, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e,
+	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x69,
+	0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x61, 0x73, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x41, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x49, 0x0a, 0x0e, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45,
+	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x3a, 0x0a, 0x19, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x73, 0x75, 0x70, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12,
+	0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x65,
+	0x77, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x4e, 0x65,
+	0x77, 0x2a, 0x62, 0x0a, 0x0d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x45, 0x4e, 0x43, 0x4f,
+	0x44, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x45, 0x4e, 0x43, 0x4f,
+	0x44, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x36, 0x34, 0x10, 0x01, 0x12, 0x16, 0x0a,
+	0x12, 0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f,
+	0x52, 0x41, 0x57, 0x10, 0x02, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f,
+	0x72, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f,
+	0x72, 0x6d, 0x3b, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -134,18 +360,21 @@ func file_terraform_field_schema_proto_rawDescGZIP() []byte {
 	return file_terraform_field_schema_proto_rawDescData
 }
 
+var file_terraform_field_schema_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_terraform_field_schema_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_terraform_field_schema_proto_goTypes = []interface{}{
-	(*FieldSchema)(nil),    // 0: protomesh.terraform.FieldSchema
-	(*structpb.Value)(nil), // 1: google.protobuf.Value
+	(BytesEncoding)(0),     // 0: protomesh.terraform.BytesEncoding
+	(*FieldSchema)(nil),    // 1: protomesh.terraform.FieldSchema
+	(*structpb.Value)(nil), // 2: google.protobuf.Value
 }
 var file_terraform_field_schema_proto_depIdxs = []int32{
-	1, // 0: protomesh.terraform.FieldSchema.default_value:type_name -> google.protobuf.Value
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: protomesh.terraform.FieldSchema.default_value:type_name -> google.protobuf.Value
+	0, // 1: protomesh.terraform.FieldSchema.bytes_encoding:type_name -> protomesh.terraform.BytesEncoding
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_terraform_field_schema_proto_init() }
@@ -172,13 +401,14 @@ func file_terraform_field_schema_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_terraform_field_schema_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_terraform_field_schema_proto_goTypes,
 		DependencyIndexes: file_terraform_field_schema_proto_depIdxs,
+		EnumInfos:         file_terraform_field_schema_proto_enumTypes,
 		MessageInfos:      file_terraform_field_schema_proto_msgTypes,
 	}.Build()
 	File_terraform_field_schema_proto = out.File
@@ -20,12 +20,67 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type NamingStrategy int32
+
+const (
+	// Same as NAMING_STRATEGY_PROTO_NAME
+	NamingStrategy_NAMING_STRATEGY_UNSPECIFIED NamingStrategy = 0
+	// Use the proto field name as the attribute name
+	NamingStrategy_NAMING_STRATEGY_PROTO_NAME NamingStrategy = 1
+	// Use the snake_case of the field json_name as the attribute name (ipv4CIDR -> ipv4_cidr)
+	NamingStrategy_NAMING_STRATEGY_JSON_NAME_SNAKE_CASE NamingStrategy = 2
+)
+
+// Enum value maps for NamingStrategy.
+var (
+	NamingStrategy_name = map[int32]string{
+		0: "NAMING_STRATEGY_UNSPECIFIED",
+		1: "NAMING_STRATEGY_PROTO_NAME",
+		2: "NAMING_STRATEGY_JSON_NAME_SNAKE_CASE",
+	}
+	NamingStrategy_value = map[string]int32{
+		"NAMING_STRATEGY_UNSPECIFIED":          0,
+		"NAMING_STRATEGY_PROTO_NAME":           1,
+		"NAMING_STRATEGY_JSON_NAME_SNAKE_CASE": 2,
+	}
+)
+
+func (x NamingStrategy) Enum() *NamingStrategy {
+	p := new(NamingStrategy)
+	*p = x
+	return p
+}
+
+func (x NamingStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NamingStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_terraform_file_schema_proto_enumTypes[0].Descriptor()
+}
+
+func (NamingStrategy) Type() protoreflect.EnumType {
+	return &file_terraform_file_schema_proto_enumTypes[0]
+}
+
+func (x NamingStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NamingStrategy.Descriptor instead.
+func (NamingStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_terraform_file_schema_proto_rawDescGZIP(), []int{0}
+}
+
 type FileSchema struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ImportMap map[string]string `protobuf:"bytes,1,rep,name=import_map,json=importMap,proto3" json:"import_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// How attribute names are derived from fields, custom names can be
+	// set for each field with FieldSchema.name
+	NamingStrategy NamingStrategy `protobuf:"varint,2,opt,name=naming_strategy,json=namingStrategy,proto3,enum=protomesh.terraform.NamingStrategy" json:"naming_strategy,omitempty"`
 }
 
 func (x *FileSchema) Reset() {
@@ -67,27 +122,47 @@ func (x *FileSchema) GetImportMap() map[string]string {
 	return nil
 }
 
+func (x *FileSchema) GetNamingStrategy() NamingStrategy {
+	if x != nil {
+		return x.NamingStrategy
+	}
+	return NamingStrategy_NAMING_STRATEGY_UNSPECIFIED
+}
+
 var File_terraform_file_schema_proto protoreflect.FileDescriptor
 
 var file_terraform_file_schema_proto_rawDesc = []byte{
 	0x0a, 0x1b, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x66, 0x69, 0x6c, 0x65,
 	0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f,
-	0x72, 0x6d, 0x22, 0x99, 0x01, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x72, 0x6d, 0x22, 0xe7, 0x01, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d,
 	0x61, 0x12, 0x4d, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x73,
 	0x68, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x65,
 	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70,
 	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70,
-	0x1a, 0x3c, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x47,
-	0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65,
-	0x6e, 0x2d, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x3b, 0x74, 0x65, 0x72, 0x72,
-	0x61, 0x66, 0x6f, 0x72, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x4c, 0x0a, 0x0f, 0x6e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e,
+	0x4e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x0e,
+	0x6e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x1a, 0x3c,
+	0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0x7b, 0x0a, 0x0e,
+	0x4e, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1f,
+	0x0a, 0x1b, 0x4e, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47,
+	0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1e, 0x0a, 0x1a, 0x4e, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45,
+	0x47, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x01, 0x12,
+	0x28, 0x0a, 0x24, 0x4e, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45,
+	0x47, 0x59, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x4e, 0x41,
+	0x4b, 0x45, 0x5f, 0x43, 0x41, 0x53, 0x45, 0x10, 0x02, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x73,
+	0x68, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x74, 0x65, 0x72,
+	0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x72,
+	0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x3b, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -102,18 +177,21 @@ func file_terraform_file_schema_proto_rawDescGZIP() []byte {
 	return file_terraform_file_schema_proto_rawDescData
 }
 
+var file_terraform_file_schema_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_terraform_file_schema_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_terraform_file_schema_proto_goTypes = []interface{}{
-	(*FileSchema)(nil), // 0: protomesh.terraform.FileSchema
-	nil,                // 1: protomesh.terraform.FileSchema.ImportMapEntry
+	(NamingStrategy)(0), // 0: protomesh.terraform.NamingStrategy
+	(*FileSchema)(nil),  // 1: protomesh.terraform.FileSchema
+	nil,                 // 2: protomesh.terraform.FileSchema.ImportMapEntry
 }
 var file_terraform_file_schema_proto_depIdxs = []int32{
-	1, // 0: protomesh.terraform.FileSchema.import_map:type_name -> protomesh.terraform.FileSchema.ImportMapEntry
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: protomesh.terraform.FileSchema.import_map:type_name -> protomesh.terraform.FileSchema.ImportMapEntry
+	0, // 1: protomesh.terraform.FileSchema.naming_strategy:type_name -> protomesh.terraform.NamingStrategy
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_terraform_file_schema_proto_init() }
@@ -140,13 +218,14 @@ func file_terraform_file_schema_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_terraform_file_schema_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_terraform_file_schema_proto_goTypes,
 		DependencyIndexes: file_terraform_file_schema_proto_depIdxs,
+		EnumInfos:         file_terraform_file_schema_proto_enumTypes,
 		MessageInfos:      file_terraform_file_schema_proto_msgTypes,
 	}.Build()
 	File_terraform_file_schema_proto = out.File
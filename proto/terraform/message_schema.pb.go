@@ -20,6 +20,278 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AttributeType int32
+
+const (
+	// Same as ATTRIBUTE_TYPE_STRING
+	AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED AttributeType = 0
+	AttributeType_ATTRIBUTE_TYPE_STRING      AttributeType = 1
+	AttributeType_ATTRIBUTE_TYPE_INT         AttributeType = 2
+	AttributeType_ATTRIBUTE_TYPE_FLOAT       AttributeType = 3
+	AttributeType_ATTRIBUTE_TYPE_BOOL        AttributeType = 4
+)
+
+// Enum value maps for AttributeType.
+var (
+	AttributeType_name = map[int32]string{
+		0: "ATTRIBUTE_TYPE_UNSPECIFIED",
+		1: "ATTRIBUTE_TYPE_STRING",
+		2: "ATTRIBUTE_TYPE_INT",
+		3: "ATTRIBUTE_TYPE_FLOAT",
+		4: "ATTRIBUTE_TYPE_BOOL",
+	}
+	AttributeType_value = map[string]int32{
+		"ATTRIBUTE_TYPE_UNSPECIFIED": 0,
+		"ATTRIBUTE_TYPE_STRING":      1,
+		"ATTRIBUTE_TYPE_INT":         2,
+		"ATTRIBUTE_TYPE_FLOAT":       3,
+		"ATTRIBUTE_TYPE_BOOL":        4,
+	}
+)
+
+func (x AttributeType) Enum() *AttributeType {
+	p := new(AttributeType)
+	*p = x
+	return p
+}
+
+func (x AttributeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttributeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_terraform_message_schema_proto_enumTypes[0].Descriptor()
+}
+
+func (AttributeType) Type() protoreflect.EnumType {
+	return &file_terraform_message_schema_proto_enumTypes[0]
+}
+
+func (x AttributeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttributeType.Descriptor instead.
+func (AttributeType) EnumDescriptor() ([]byte, []int) {
+	return file_terraform_message_schema_proto_rawDescGZIP(), []int{0}
+}
+
+// Terraform only attribute, it is part of the schema but not mapped into the proto
+type VirtualAttribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Attribute name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Attribute type
+	Type AttributeType `protobuf:"varint,2,opt,name=type,proto3,enum=protomesh.terraform.AttributeType" json:"type,omitempty"`
+	// Attribute description
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Is this attribute required
+	Required bool `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	// Is this attribute computed
+	Computed bool `protobuf:"varint,5,opt,name=computed,proto3" json:"computed,omitempty"`
+}
+
+func (x *VirtualAttribute) Reset() {
+	*x = VirtualAttribute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_terraform_message_schema_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VirtualAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualAttribute) ProtoMessage() {}
+
+func (x *VirtualAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_terraform_message_schema_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VirtualAttribute.ProtoReflect.Descriptor instead.
+func (*VirtualAttribute) Descriptor() ([]byte, []int) {
+	return file_terraform_message_schema_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VirtualAttribute) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VirtualAttribute) GetType() AttributeType {
+	if x != nil {
+		return x.Type
+	}
+	return AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+func (x *VirtualAttribute) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *VirtualAttribute) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *VirtualAttribute) GetComputed() bool {
+	if x != nil {
+		return x.Computed
+	}
+	return false
+}
+
+// Default timeouts of the resource operations, as durations (20m, 1h30m)
+type ResourceTimeouts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Create string `protobuf:"bytes,1,opt,name=create,proto3" json:"create,omitempty"`
+	Read   string `protobuf:"bytes,2,opt,name=read,proto3" json:"read,omitempty"`
+	Update string `protobuf:"bytes,3,opt,name=update,proto3" json:"update,omitempty"`
+	Delete string `protobuf:"bytes,4,opt,name=delete,proto3" json:"delete,omitempty"`
+}
+
+func (x *ResourceTimeouts) Reset() {
+	*x = ResourceTimeouts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_terraform_message_schema_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceTimeouts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceTimeouts) ProtoMessage() {}
+
+func (x *ResourceTimeouts) ProtoReflect() protoreflect.Message {
+	mi := &file_terraform_message_schema_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceTimeouts.ProtoReflect.Descriptor instead.
+func (*ResourceTimeouts) Descriptor() ([]byte, []int) {
+	return file_terraform_message_schema_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResourceTimeouts) GetCreate() string {
+	if x != nil {
+		return x.Create
+	}
+	return ""
+}
+
+func (x *ResourceTimeouts) GetRead() string {
+	if x != nil {
+		return x.Read
+	}
+	return ""
+}
+
+func (x *ResourceTimeouts) GetUpdate() string {
+	if x != nil {
+		return x.Update
+	}
+	return ""
+}
+
+func (x *ResourceTimeouts) GetDelete() string {
+	if x != nil {
+		return x.Delete
+	}
+	return ""
+}
+
+// Previous definition of a resource, its state is upgraded to the next version
+type StateUpgrade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Schema version of the definition
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Full name of the message describing the resource at this version
+	// (usually a nested message, my.package.Widget.V1)
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *StateUpgrade) Reset() {
+	*x = StateUpgrade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_terraform_message_schema_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateUpgrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateUpgrade) ProtoMessage() {}
+
+func (x *StateUpgrade) ProtoReflect() protoreflect.Message {
+	mi := &file_terraform_message_schema_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateUpgrade.ProtoReflect.Descriptor instead.
+func (*StateUpgrade) Descriptor() ([]byte, []int) {
+	return file_terraform_message_schema_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StateUpgrade) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *StateUpgrade) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type MessageSchema struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -29,12 +301,31 @@ type MessageSchema struct {
 	Generate bool `protobuf:"varint,1,opt,name=generate,proto3" json:"generate,omitempty"`
 	// Is resource (get/set values from/to resource data)
 	IsResource bool `protobuf:"varint,2,opt,name=is_resource,json=isResource,proto3" json:"is_resource,omitempty"`
+	// Deprecation message for this resource (defaults to a generic text when
+	// the message has the deprecated option)
+	DeprecationMessage string `protobuf:"bytes,3,opt,name=deprecation_message,json=deprecationMessage,proto3" json:"deprecation_message,omitempty"`
+	// Extra attributes added to the schema
+	VirtualAttributes []*VirtualAttribute `protobuf:"bytes,4,rep,name=virtual_attributes,json=virtualAttributes,proto3" json:"virtual_attributes,omitempty"`
+	// Levels of nested blocks generated for recursive fields of this message,
+	// past it they are JSON string attributes with the proto JSON of the field.
+	// It defaults to 0: recursive fields are JSON strings unless it is set
+	MaxDepth uint32 `protobuf:"varint,5,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	// Template of the resource ID with the proto names of the identifying
+	// fields between braces (projects/{project}/widgets/{name})
+	IdFields string `protobuf:"bytes,6,opt,name=id_fields,json=idFields,proto3" json:"id_fields,omitempty"`
+	// Default timeouts of the resource operations
+	Timeouts *ResourceTimeouts `protobuf:"bytes,7,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
+	// Version of the resource schema, bump it when the shape of the message
+	// changes in a way breaking the existing states
+	SchemaVersion uint32 `protobuf:"varint,8,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// Previous definitions of the resource, one per version below schema_version
+	StateUpgrades []*StateUpgrade `protobuf:"bytes,9,rep,name=state_upgrades,json=stateUpgrades,proto3" json:"state_upgrades,omitempty"`
 }
 
 func (x *MessageSchema) Reset() {
 	*x = MessageSchema{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_terraform_message_schema_proto_msgTypes[0]
+		mi := &file_terraform_message_schema_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -47,7 +338,7 @@ func (x *MessageSchema) String() string {
 func (*MessageSchema) ProtoMessage() {}
 
 func (x *MessageSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_terraform_message_schema_proto_msgTypes[0]
+	mi := &file_terraform_message_schema_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -60,7 +351,7 @@ func (x *MessageSchema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageSchema.ProtoReflect.Descriptor instead.
 func (*MessageSchema) Descriptor() ([]byte, []int) {
-	return file_terraform_message_schema_proto_rawDescGZIP(), []int{0}
+	return file_terraform_message_schema_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *MessageSchema) GetGenerate() bool {
@@ -77,23 +368,128 @@ func (x *MessageSchema) GetIsResource() bool {
 	return false
 }
 
+func (x *MessageSchema) GetDeprecationMessage() string {
+	if x != nil {
+		return x.DeprecationMessage
+	}
+	return ""
+}
+
+func (x *MessageSchema) GetVirtualAttributes() []*VirtualAttribute {
+	if x != nil {
+		return x.VirtualAttributes
+	}
+	return nil
+}
+
+func (x *MessageSchema) GetMaxDepth() uint32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *MessageSchema) GetIdFields() string {
+	if x != nil {
+		return x.IdFields
+	}
+	return ""
+}
+
+func (x *MessageSchema) GetTimeouts() *ResourceTimeouts {
+	if x != nil {
+		return x.Timeouts
+	}
+	return nil
+}
+
+func (x *MessageSchema) GetSchemaVersion() uint32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *MessageSchema) GetStateUpgrades() []*StateUpgrade {
+	if x != nil {
+		return x.StateUpgrades
+	}
+	return nil
+}
+
 var File_terraform_message_schema_proto protoreflect.FileDescriptor
 
 var file_terraform_message_schema_proto_rawDesc = []byte{
 	0x0a, 0x1e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x6d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x74, 0x65, 0x72, 0x72,
-	0x61, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0x4c, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x61, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0xb8, 0x01, 0x0a, 0x10, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f,
+	0x72, 0x6d, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64,
+	0x22, 0x6e, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x61, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x22, 0x42, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0xc1, 0x03, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
 	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
 	0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d,
-	0x3b, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x54, 0x0a, 0x12, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x74, 0x65, 0x72,
+	0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x11, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
+	0x78, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d,
+	0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x64, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x41, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x48,
+	0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2a, 0x95, 0x01, 0x0a, 0x0d, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x54,
+	0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x54,
+	0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x52,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55,
+	0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x18, 0x0a,
+	0x14, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x46, 0x4c, 0x4f, 0x41, 0x54, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x54, 0x54, 0x52, 0x49,
+	0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4f, 0x4f, 0x4c, 0x10, 0x04,
+	0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
+	0x67, 0x65, 0x6e, 0x2d, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x3b, 0x74, 0x65,
+	0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -108,16 +504,25 @@ func file_terraform_message_schema_proto_rawDescGZIP() []byte {
 	return file_terraform_message_schema_proto_rawDescData
 }
 
-var file_terraform_message_schema_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_terraform_message_schema_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_terraform_message_schema_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_terraform_message_schema_proto_goTypes = []interface{}{
-	(*MessageSchema)(nil), // 0: protomesh.terraform.MessageSchema
+	(AttributeType)(0),       // 0: protomesh.terraform.AttributeType
+	(*VirtualAttribute)(nil), // 1: protomesh.terraform.VirtualAttribute
+	(*ResourceTimeouts)(nil), // 2: protomesh.terraform.ResourceTimeouts
+	(*StateUpgrade)(nil),     // 3: protomesh.terraform.StateUpgrade
+	(*MessageSchema)(nil),    // 4: protomesh.terraform.MessageSchema
 }
 var file_terraform_message_schema_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: protomesh.terraform.VirtualAttribute.type:type_name -> protomesh.terraform.AttributeType
+	1, // 1: protomesh.terraform.MessageSchema.virtual_attributes:type_name -> protomesh.terraform.VirtualAttribute
+	2, // 2: protomesh.terraform.MessageSchema.timeouts:type_name -> protomesh.terraform.ResourceTimeouts
+	3, // 3: protomesh.terraform.MessageSchema.state_upgrades:type_name -> protomesh.terraform.StateUpgrade
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_terraform_message_schema_proto_init() }
@@ -127,6 +532,42 @@ func file_terraform_message_schema_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_terraform_message_schema_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VirtualAttribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_terraform_message_schema_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceTimeouts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_terraform_message_schema_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateUpgrade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_terraform_message_schema_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MessageSchema); i {
 			case 0:
 				return &v.state
@@ -144,13 +585,14 @@ func file_terraform_message_schema_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_terraform_message_schema_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_terraform_message_schema_proto_goTypes,
 		DependencyIndexes: file_terraform_message_schema_proto_depIdxs,
+		EnumInfos:         file_terraform_message_schema_proto_enumTypes,
 		MessageInfos:      file_terraform_message_schema_proto_msgTypes,
 	}.Build()
 	File_terraform_message_schema_proto = out.File
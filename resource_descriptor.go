@@ -0,0 +1,189 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Number of the google.api.resource extension of the message options, it is read from
+// the wire format to not depend on the googleapis generated packages
+const googleAPIResourceExtension = 1053
+
+const (
+	resourceDescriptorPatternField   = 2
+	resourceDescriptorNameFieldField = 3
+)
+
+// Placeholders of the segments of a resource name pattern ({project} or {name=**})
+var patternSegmentRegexp = regexp.MustCompile(`\{([^}=]+)(=[^}]*)?\}`)
+
+type resourceDescriptor struct {
+	patterns  []string
+	nameField string
+}
+
+// getResourceDescriptor returns the google.api.resource option of the message, nil if unset
+func getResourceDescriptor(desc protoreflect.MessageDescriptor) *resourceDescriptor {
+
+	opts, ok := desc.Options().(*descriptorpb.MessageOptions)
+	if !ok {
+		panic("Invalid message options")
+	}
+
+	if opts == nil {
+		return nil
+	}
+
+	// Registered or not, the extension is kept in the serialized options
+	b, err := proto.Marshal(opts)
+	if err != nil {
+		panic(err)
+	}
+
+	value, found := consumeBytesField(b, googleAPIResourceExtension)
+	if !found {
+		return nil
+	}
+
+	rd := &resourceDescriptor{
+		patterns:  []string{},
+		nameField: "name",
+	}
+
+	for len(value) > 0 {
+
+		num, typ, n := protowire.ConsumeTag(value)
+		if n < 0 {
+			panic(fmt.Sprintf("invalid google.api.resource of %s: %v", desc.FullName(), protowire.ParseError(n)))
+		}
+
+		value = value[n:]
+
+		if typ == protowire.BytesType && (num == resourceDescriptorPatternField || num == resourceDescriptorNameFieldField) {
+
+			s, n := protowire.ConsumeString(value)
+			if n < 0 {
+				panic(fmt.Sprintf("invalid google.api.resource of %s: %v", desc.FullName(), protowire.ParseError(n)))
+			}
+
+			value = value[n:]
+
+			if num == resourceDescriptorPatternField {
+				rd.patterns = append(rd.patterns, s)
+			} else if len(s) > 0 {
+				rd.nameField = s
+			}
+
+			continue
+
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, value)
+		if n < 0 {
+			panic(fmt.Sprintf("invalid google.api.resource of %s: %v", desc.FullName(), protowire.ParseError(n)))
+		}
+
+		value = value[n:]
+
+	}
+
+	if len(rd.patterns) == 0 {
+		return nil
+	}
+
+	return rd
+
+}
+
+func consumeBytesField(b []byte, number protowire.Number) ([]byte, bool) {
+
+	for len(b) > 0 {
+
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, false
+		}
+
+		b = b[n:]
+
+		if num == number && typ == protowire.BytesType {
+
+			value, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return nil, false
+			}
+
+			return value, true
+
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		if n < 0 {
+			return nil, false
+		}
+
+		b = b[n:]
+
+	}
+
+	return nil, false
+
+}
+
+// segments returns the segment names of all patterns, in order and without duplicates
+func (rd *resourceDescriptor) segments() []string {
+
+	segments := []string{}
+	seen := map[string]bool{}
+
+	for _, pattern := range rd.patterns {
+
+		for _, match := range patternSegmentRegexp.FindAllStringSubmatch(pattern, -1) {
+
+			if !seen[match[1]] {
+				segments = append(segments, match[1])
+				seen[match[1]] = true
+			}
+
+		}
+
+	}
+
+	return segments
+
+}
+
+// patternRegexp returns the regular expression matching the names of the pattern,
+// with a group for each of its segments
+func patternRegexp(pattern string) (string, []string) {
+
+	expr := "^"
+	segments := []string{}
+
+	literals := patternSegmentRegexp.Split(pattern, -1)
+
+	for i, match := range patternSegmentRegexp.FindAllStringSubmatch(pattern, -1) {
+
+		expr += regexp.QuoteMeta(literals[i])
+
+		// Segments with a path wildcard ({name=**}) span several path components
+		if match[2] == "=**" {
+			expr += "(.+)"
+		} else {
+			expr += "([^/]+)"
+		}
+
+		segments = append(segments, match[1])
+
+	}
+
+	expr += regexp.QuoteMeta(literals[len(literals)-1]) + "$"
+
+	return expr, segments
+
+}